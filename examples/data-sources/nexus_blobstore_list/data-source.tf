@@ -1 +1,7 @@
 data "nexus_blobstore_list" "all" {}
+
+data "nexus_blobstore_list" "over_quota" {
+  type         = "File"
+  name_regex   = "^team-"
+  quota_status = "VIOLATION"
+}
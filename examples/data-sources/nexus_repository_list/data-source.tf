@@ -1 +1,12 @@
 data "nexus_repository_list" "all" {}
+
+# Every npm proxy, e.g. to add them all to an npm group
+data "nexus_repository_list" "npm_proxies" {
+  format = "npm"
+  type   = "proxy"
+}
+
+data "nexus_repository_list" "team" {
+  name_regex      = "^team-a-"
+  blob_store_name = "team-a"
+}
@@ -0,0 +1,5 @@
+data "nexus_server_info" "server" {}
+
+output "nexus_version" {
+  value = "${data.nexus_server_info.server.edition} ${data.nexus_server_info.server.version}"
+}
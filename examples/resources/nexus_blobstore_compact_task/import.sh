@@ -0,0 +1,2 @@
+# import using the id of the scheduled task
+terraform import nexus_blobstore_compact_task.nightly 3a2c1d6e-0b4f-4c55-9f7b-2d1e6c0a9b8f
@@ -0,0 +1,37 @@
+resource "nexus_blobstore_file" "file" {
+  name = "blobstore-file"
+  path = "/nexus-data/blobstore-file"
+}
+
+resource "nexus_blobstore_compact_task" "nightly" {
+  name           = "compact-blobstore-file"
+  blobstore_name = nexus_blobstore_file.file.name
+  alert_email    = "ops@example.com"
+
+  schedule = {
+    type            = "cron"
+    cron_expression = "0 0 2 * * ?"
+  }
+}
+
+resource "nexus_blobstore_compact_task" "weekend" {
+  name           = "compact-blobstore-file-weekend"
+  blobstore_name = nexus_blobstore_file.file.name
+
+  schedule = {
+    type           = "weekly"
+    start_date     = "2024-01-06T03:00:00+01:00"
+    recurring_days = ["SAT", "SUN"]
+  }
+}
+
+resource "nexus_blobstore_compact_task" "monthly" {
+  name           = "compact-blobstore-file-monthly"
+  blobstore_name = nexus_blobstore_file.file.name
+
+  schedule = {
+    type          = "monthly"
+    start_date    = "2024-01-01T04:00:00+01:00"
+    days_of_month = [1, 999]
+  }
+}
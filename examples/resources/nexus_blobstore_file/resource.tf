@@ -2,8 +2,8 @@ resource "nexus_blobstore_file" "file" {
   name = "blobstore-file"
   path = "/nexus-data/blobstore-file"
 
-  soft_quota {
-    limit = 1024000000
-    type  = "spaceRemainingQuota"
+  soft_quota = {
+    limit_bytes = 1024000000
+    type        = "spaceRemainingQuota"
   }
 }
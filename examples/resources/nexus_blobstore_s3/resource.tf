@@ -1,20 +1,21 @@
 resource "nexus_blobstore_s3" "aws" {
   name = "blobstore-s3"
 
-  bucket_configuration {
-    bucket {
-      name   = "aws-bucket-name"
-      region = "us-central-1"
+  bucket_configuration = {
+    bucket = {
+      name       = "aws-bucket-name"
+      region     = "us-central-1"
+      expiration = 3
     }
 
-    bucket_security {
+    bucket_security = {
       access_key_id     = "<your-aws-access-key-id>"
       secret_access_key = "<your-aws-secret-access-key>"
     }
   }
 
-  soft_quota {
-    limit = 1024
+  soft_quota = {
+    limit = "2TB"
     type  = "spaceRemainingQuota"
   }
 }
@@ -6,27 +6,31 @@ resource "nexus_repository_apt_proxy" "bionic_proxy" {
   distribution = "bionic"
   flat         = false
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://remote.repository.com"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  cleanup = {
+    policy_names = ["cleanup-debs"]
+  }
+
+  http_client = {
     blocked    = false
     auto_block = true
 
-    connection {
+    connection = {
       retries                   = 0
       user_agent_suffix         = "string"
       timeout                   = 60
@@ -35,7 +39,7 @@ resource "nexus_repository_apt_proxy" "bionic_proxy" {
       use_trust_store           = false
     }
 
-    authentication {
+    authentication = {
       type     = "username"
       username = "admin"
       password = "admin-password"
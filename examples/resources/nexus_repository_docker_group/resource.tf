@@ -1,13 +1,13 @@
 resource "nexus_repository_docker_hosted" "internal" {
   name = "internal"
 
-  docker {
+  docker = {
     force_basic_auth = false
     v1_enabled       = false
-    subdomain        = "docker" # Pro-only
+    subdomain        = "internal" # Pro-only
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
     write_policy                   = "ALLOW"
@@ -17,33 +17,33 @@ resource "nexus_repository_docker_hosted" "internal" {
 resource "nexus_repository_docker_proxy" "dockerhub" {
   name = "dockerhub"
 
-  docker {
+  docker = {
     force_basic_auth = false
     v1_enabled       = false
-    subdomain        = "docker"
+    subdomain        = "dockerhub"
   }
 
-  docker_proxy {
+  docker_proxy = {
     index_type = "HUB"
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://registry-1.docker.io"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  http_client = {
     blocked    = false
     auto_block = true
   }
@@ -53,7 +53,7 @@ resource "nexus_repository_docker_group" "group" {
   name   = "docker-group"
   online = true
 
-  docker {
+  docker = {
     force_basic_auth = false
     http_port        = 8080
     https_port       = 8433
@@ -61,7 +61,7 @@ resource "nexus_repository_docker_group" "group" {
     subdomain        = "docker"
   }
 
-  group {
+  group = {
     member_names = [
       nexus_repository_docker_hosted.internal.name,
       nexus_repository_docker_proxy.dockerhub.name
@@ -69,7 +69,7 @@ resource "nexus_repository_docker_group" "group" {
     writable_member = nexus_repository_docker_hosted.internal.name
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
@@ -2,31 +2,30 @@ resource "nexus_repository_maven_hosted" "releases" {
   name   = "maven-releases"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = false
     write_policy                   = "ALLOW"
   }
 
-  maven {
+  maven = {
     version_policy      = "RELEASE"
     layout_policy       = "STRICT"
     content_disposition = "INLINE"
   }
 }
 
-
 resource "nexus_repository_maven_group" "group" {
   name   = "maven-group"
   online = true
 
-  group {
+  group = {
     member_names = [
       nexus_repository_maven_hosted.releases.name,
     ]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
@@ -2,28 +2,28 @@ resource "nexus_repository_maven_proxy" "maven_central" {
   name   = "maven-central-repo1"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://repo1.maven.org/maven2/"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  http_client = {
     blocked    = false
     auto_block = true
   }
 
-  maven {
+  maven = {
     version_policy = "RELEASE"
     layout_policy  = "PERMISSIVE"
   }
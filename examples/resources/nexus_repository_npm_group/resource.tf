@@ -2,24 +2,49 @@ resource "nexus_repository_npm_hosted" "internal" {
   name   = "internal"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
     write_policy                   = "ALLOW"
   }
 }
 
+resource "nexus_repository_npm_proxy" "npmjs" {
+  name   = "npmjs"
+  online = true
+
+  storage = {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url = "https://registry.npmjs.org"
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+  }
+}
+
 resource "nexus_repository_npm_group" "group" {
   name   = "npm-group"
   online = true
 
-  group {
+  group = {
     member_names = [
       nexus_repository_npm_hosted.internal.name,
+      nexus_repository_npm_proxy.npmjs.name,
     ]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
@@ -1,16 +1,20 @@
 resource "nexus_repository_npm_hosted" "npm" {
   name = "npm"
+
+  storage = {
+    blob_store_name = "default"
+  }
 }
 
 resource "nexus_repository_npm_hosted" "npm1" {
   name   = "npm1"
   online = true
 
-  cleanup {
+  cleanup = {
     policy_names = ["policy"]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
     write_policy                   = "ALLOW"
@@ -2,24 +2,29 @@ resource "nexus_repository_npm_proxy" "npmjs" {
   name   = "npmjs"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://npmjs.org/"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  http_client = {
     blocked    = false
     auto_block = true
   }
+
+  npm = {
+    remove_non_cataloged = false
+    remove_quarantined   = false
+  }
 }
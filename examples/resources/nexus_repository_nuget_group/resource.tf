@@ -2,7 +2,7 @@ resource "nexus_repository_nuget_hosted" "internal" {
   name   = "internal"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
     write_policy                   = "ALLOW"
@@ -16,23 +16,23 @@ resource "nexus_repository_nuget_proxy" "nuget_org" {
   nuget_version            = "V3"
   query_cache_item_max_age = 3600
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://api.nuget.org/v3/index.json"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  http_client = {
     blocked    = false
     auto_block = true
   }
@@ -42,14 +42,14 @@ resource "nexus_repository_nuget_group" "group" {
   name   = "nuget-group"
   online = true
 
-  group {
+  group = {
     member_names = [
       nexus_repository_nuget_hosted.internal.name,
       nexus_repository_nuget_proxy.nuget_org.name,
     ]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
@@ -2,7 +2,7 @@ resource "nexus_repository_pypi_hosted" "internal" {
   name   = "internal"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
     write_policy                   = "ALLOW"
@@ -13,23 +13,23 @@ resource "nexus_repository_pypi_proxy" "pypi_org" {
   name   = "pypi-org"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
 
-  proxy {
+  proxy = {
     remote_url       = "https://pypi.org"
     content_max_age  = 1440
     metadata_max_age = 1440
   }
 
-  negative_cache {
+  negative_cache = {
     enabled = true
     ttl     = 1440
   }
 
-  http_client {
+  http_client = {
     blocked    = false
     auto_block = true
   }
@@ -39,14 +39,14 @@ resource "nexus_repository_pypi_group" "group" {
   name   = "pypi-group"
   online = true
 
-  group {
+  group = {
     member_names = [
       nexus_repository_pypi_hosted.internal.name,
       nexus_repository_pypi_proxy.pypi_org.name,
     ]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
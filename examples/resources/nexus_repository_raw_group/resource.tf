@@ -2,7 +2,7 @@ resource "nexus_repository_raw_hosted" "internal" {
   name   = "raw-internal"
   online = true
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = false
     write_policy                   = "ALLOW"
@@ -10,18 +10,17 @@ resource "nexus_repository_raw_hosted" "internal" {
 
 }
 
-
 resource "nexus_repository_raw_group" "group" {
   name   = "raw-group"
   online = true
 
-  group {
+  group = {
     member_names = [
       nexus_repository_raw_hosted.internal.name,
     ]
   }
 
-  storage {
+  storage = {
     blob_store_name                = "default"
     strict_content_type_validation = true
   }
@@ -0,0 +1,121 @@
+// Package acctest runs the provider against the in-memory nexus of package
+// mocknexus with terraform-plugin-testing, see resource.UnitTest.
+package acctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	provider "github.com/serialt/terraform-provider-nexus/internal"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// ProtoV6ProviderFactories serves the provider in process for the test steps.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"nexus": providerserver.NewProtocol6WithError(provider.New()),
+}
+
+// NewServer starts an empty nexus which is closed when the test finishes.
+func NewServer(t *testing.T) *mocknexus.Server {
+	t.Helper()
+	server := mocknexus.NewServer()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// CheckBlobstore checks a value of the blob store configuration nexus
+// received, key is a dot separated path such as "softQuota.limit".
+func CheckBlobstore(server *mocknexus.Server, name string, key string, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config, ok := server.Blobstore(name)
+		if !ok {
+			return fmt.Errorf("blobstore %q does not exist", name)
+		}
+		return checkValue(config, key, want, "blobstore "+name)
+	}
+}
+
+// CheckRepository checks a value of the repository nexus received, key is a
+// dot separated path such as "storage.blobStoreName".
+func CheckRepository(server *mocknexus.Server, name string, key string, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		repo, ok := server.Repository(name)
+		if !ok {
+			return fmt.Errorf("repository %q does not exist", name)
+		}
+		return checkValue(repo, key, want, "repository "+name)
+	}
+}
+
+// CheckTask checks a value of the task nexus received for a resource, key is
+// a dot separated path such as "frequency.schedule".
+func CheckTask(server *mocknexus.Server, resourceName string, key string, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q is not in the state", resourceName)
+		}
+		t, ok := server.Task(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("task %q does not exist", rs.Primary.ID)
+		}
+		return checkValue(t, key, want, "task "+rs.Primary.ID)
+	}
+}
+
+// CheckDestroyed checks that none of the blob stores, repositories and tasks
+// of the state is left in nexus.
+func CheckDestroyed(server *mocknexus.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			var exists bool
+			switch {
+			case strings.HasSuffix(rs.Type, "_task"):
+				_, exists = server.Task(rs.Primary.ID)
+			case strings.HasPrefix(rs.Type, "nexus_blobstore_"):
+				_, exists = server.Blobstore(rs.Primary.ID)
+			case strings.HasPrefix(rs.Type, "nexus_repository_"):
+				_, exists = server.Repository(rs.Primary.ID)
+			}
+			if exists {
+				return fmt.Errorf("%s %q still exists", rs.Type, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func checkValue(object map[string]interface{}, key string, want string, what string) error {
+	var value interface{} = object
+	for _, field := range strings.Split(key, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %s is not set", what, key)
+		}
+		value = m[field]
+	}
+	var got string
+	switch v := value.(type) {
+	case nil:
+	case string:
+		got = v
+	case float64:
+		got = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		got = strconv.FormatBool(v)
+	default:
+		data, _ := json.Marshal(v)
+		got = string(data)
+	}
+	if got != want {
+		return fmt.Errorf("%s: %s is %q, want %q", what, key, got, want)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &BlobStoreAzureSource{}
+
+func NewBlobStoreAzureSource() datasource.DataSource {
+	return &BlobStoreAzureSource{}
+}
+
+type BlobStoreAzureSource struct {
+	client *nexusclient.Client
+}
+
+type BlobStoreAzureSourceModel struct {
+	Id                    types.String                         `tfsdk:"id"`
+	Name                  types.String                         `tfsdk:"name"`
+	BlobCount             types.Int64                          `tfsdk:"blob_count"`
+	AvailableSpaceInBytes types.Int64                          `tfsdk:"available_space_in_bytes"`
+	TotalSizeInBytes      types.Int64                          `tfsdk:"total_size_in_bytes"`
+	SoftQuota             *SoftQuotaModel                      `tfsdk:"soft_quota"`
+	BucketConfiguration   *azureBucketConfigurationSourceModel `tfsdk:"bucket_configuration"`
+}
+
+type azureBucketConfigurationSourceModel struct {
+	AccountName    types.String                    `tfsdk:"account_name"`
+	ContainerName  types.String                    `tfsdk:"container_name"`
+	Authentication *azureAuthenticationSourceModel `tfsdk:"authentication"`
+}
+
+type azureAuthenticationSourceModel struct {
+	AuthenticationMethod types.String `tfsdk:"authentication_method"`
+}
+
+func (d *BlobStoreAzureSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blobstore_azure"
+}
+
+func (d *BlobStoreAzureSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `~> PRO Feature
+
+Use this data source to get details of an existing Nexus Azure blobstore.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Blobstore name",
+				Required:    true,
+			},
+			"available_space_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space in Bytes",
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total size of the blobstore in Bytes",
+			},
+			"blob_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Count of blobs",
+			},
+			"soft_quota": softQuotaDataSourceSchema(),
+			"bucket_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Azure specific configuration details for the Azure object that'll contain the blob store",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"account_name": schema.StringAttribute{
+						Description: "Account name found under Access keys for the storage account",
+						Computed:    true,
+					},
+					"container_name": schema.StringAttribute{
+						Description: "The name of an existing container to be used for storage",
+						Computed:    true,
+					},
+					"authentication": schema.SingleNestedAttribute{
+						Description: "The Azure specific authentication details",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"authentication_method": schema.StringAttribute{
+								MarkdownDescription: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
+								Computed:            true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *BlobStoreAzureSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *BlobStoreAzureSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state, newState BlobStoreAzureSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	bs, err := d.client.BlobStore.Azure.Get(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get blobStore azure failed", err.Error())
+		return
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(d.client, bs.Name)
+	if err != nil {
+		resp.Diagnostics.AddError("Get blobStore list failed", err.Error())
+		return
+	}
+
+	newState = BlobStoreAzureSourceModel{
+		Id:                    types.StringValue(bs.Name),
+		Name:                  types.StringValue(bs.Name),
+		AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
+		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
+		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
+		BucketConfiguration: &azureBucketConfigurationSourceModel{
+			AccountName:   types.StringValue(bs.BucketConfiguration.AccountName),
+			ContainerName: types.StringValue(bs.BucketConfiguration.ContainerName),
+			Authentication: &azureAuthenticationSourceModel{
+				AuthenticationMethod: types.StringValue(string(bs.BucketConfiguration.Authentication.AuthenticationMethod)),
+			},
+		},
+	}
+	newState.SoftQuota = flattenSoftQuota(bs.SoftQuota, true)
+
+	tflog.Trace(ctx, "read a BlobStoreAzure data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
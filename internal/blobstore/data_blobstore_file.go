@@ -8,8 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
-	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
 )
 
 var _ datasource.DataSource = &BlobStoreFileSource{}
@@ -19,7 +18,7 @@ func NewBlobStoreFileSource() datasource.DataSource {
 }
 
 type BlobStoreFileSource struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type BlobStoreFileSourceModel struct {
@@ -32,18 +31,13 @@ type BlobStoreFileSourceModel struct {
 	SoftQuota             *SoftQuotaModel `tfsdk:"soft_quota"`
 }
 
-type SoftQuotaModel struct {
-	Limit types.Int64  `tfsdk:"limit"`
-	Type  types.String `tfsdk:"type"`
-}
-
 func (d *BlobStoreFileSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_blobstore_file"
 }
 
 func (d *BlobStoreFileSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Example data source",
+		MarkdownDescription: "Use this data source to get details of an existing Nexus file blobstore.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -70,20 +64,7 @@ func (d *BlobStoreFileSource) Schema(ctx context.Context, req datasource.SchemaR
 				Computed:    true,
 				Description: "Count of blobs",
 			},
-			"soft_quota": schema.SingleNestedAttribute{
-				MarkdownDescription: "Soft quota of the blobstore",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"limit": schema.Int64Attribute{
-						Description: "The limit in Bytes. Minimum value is 1000000",
-						Computed:    true,
-					},
-					"type": schema.StringAttribute{
-						Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
-						Computed:    true,
-					},
-				},
-			},
+			"soft_quota": softQuotaDataSourceSchema(),
 		},
 	}
 }
@@ -92,11 +73,11 @@ func (d *BlobStoreFileSource) Configure(ctx context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -114,21 +95,15 @@ func (d *BlobStoreFileSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 	blobStoreFile, err := d.client.BlobStore.File.Get(state.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
+		resp.Diagnostics.AddError("Get blobStore file failed", err.Error())
 		return
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := d.client.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(d.client, blobStoreFile.Name)
 	if err != nil {
-		resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
+		resp.Diagnostics.AddError("Get blobStore list failed", err.Error())
 		return
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == blobStoreFile.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	newState = BlobStoreFileSourceModel{
 		Id:                    types.StringValue(blobStoreFile.Name),
@@ -138,12 +113,7 @@ func (d *BlobStoreFileSource) Read(ctx context.Context, req datasource.ReadReque
 		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
 		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
 	}
-	if blobStoreFile.SoftQuota != nil {
-		newState.SoftQuota = &SoftQuotaModel{
-			Limit: types.Int64Value(blobStoreFile.SoftQuota.Limit / (1024 * 1024)),
-			Type:  types.StringValue(blobStoreFile.SoftQuota.Type),
-		}
-	}
+	newState.SoftQuota = flattenSoftQuota(blobStoreFile.SoftQuota, true)
 
 	tflog.Trace(ctx, "read a blobStoreFile data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -8,8 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
-	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
 )
 
 var _ datasource.DataSource = &BlobStoreGroupSource{}
@@ -19,7 +18,7 @@ func NewBlobStoreGroupSource() datasource.DataSource {
 }
 
 type BlobStoreGroupSource struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type BlobStoreGroupSourceModel struct {
@@ -72,20 +71,7 @@ func (d *BlobStoreGroupSource) Schema(ctx context.Context, req datasource.Schema
 				Computed:    true,
 				ElementType: types.StringType,
 			},
-			"soft_quota": schema.SingleNestedAttribute{
-				MarkdownDescription: "Soft quota of the blobstore",
-				Optional:            true,
-				Attributes: map[string]schema.Attribute{
-					"limit": schema.Int64Attribute{
-						Description: "The limit in Bytes. Minimum value is 1000000",
-						Computed:    true,
-					},
-					"type": schema.StringAttribute{
-						Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
-						Computed:    true,
-					},
-				},
-			},
+			"soft_quota": softQuotaDataSourceSchema(),
 		},
 	}
 
@@ -95,11 +81,11 @@ func (d *BlobStoreGroupSource) Configure(ctx context.Context, req datasource.Con
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -123,17 +109,11 @@ func (d *BlobStoreGroupSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := d.client.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(d.client, bs.Name)
 	if err != nil {
 		resp.Diagnostics.AddError("Get blobStore list failed", err.Error())
 		return
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == bs.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	members := []types.String{}
 	for _, member := range bs.Members {
@@ -147,11 +127,8 @@ func (d *BlobStoreGroupSource) Read(ctx context.Context, req datasource.ReadRequ
 		FillPolicy:            types.StringValue(bs.FillPolicy),
 		Members:               members,
 		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
-		SoftQuota: &SoftQuotaModel{
-			Limit: types.Int64Value(bs.SoftQuota.Limit),
-			Type:  types.StringValue(bs.SoftQuota.Type),
-		},
 	}
+	newState.SoftQuota = flattenSoftQuota(bs.SoftQuota, true)
 
 	tflog.Trace(ctx, "read a BlobStoreGroup data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -3,12 +3,24 @@ package blobstore
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+const (
+	QuotaStatusNone      = "NONE"
+	QuotaStatusOK        = "OK"
+	QuotaStatusViolation = "VIOLATION"
 )
 
 var _ datasource.DataSource = &BlobStoreListSource{}
@@ -18,17 +30,27 @@ func NewBlobStoreListSource() datasource.DataSource {
 }
 
 type BlobStoreListSource struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type BlobStoreListSourceModel struct {
-	Id    types.String                    `tfsdk:"id"`
-	Items []*BlobStoreListSourceItemModel `tfsdk:"items"`
+	Id          types.String                    `tfsdk:"id"`
+	Type        types.String                    `tfsdk:"type"`
+	NameRegex   types.String                    `tfsdk:"name_regex"`
+	QuotaStatus types.String                    `tfsdk:"quota_status"`
+	Items       []*BlobStoreListSourceItemModel `tfsdk:"items"`
 }
 
 type BlobStoreListSourceItemModel struct {
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	Name                  types.String    `tfsdk:"name"`
+	Type                  types.String    `tfsdk:"type"`
+	Unavailable           types.Bool      `tfsdk:"unavailable"`
+	BlobCount             types.Int64     `tfsdk:"blob_count"`
+	TotalSizeInBytes      types.Int64     `tfsdk:"total_size_in_bytes"`
+	AvailableSpaceInBytes types.Int64     `tfsdk:"available_space_in_bytes"`
+	SoftQuota             *SoftQuotaModel `tfsdk:"soft_quota"`
+	QuotaStatus           types.String    `tfsdk:"quota_status"`
+	QuotaMessage          types.String    `tfsdk:"quota_message"`
 }
 
 func (d *BlobStoreListSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -37,15 +59,36 @@ func (d *BlobStoreListSource) Metadata(ctx context.Context, req datasource.Metad
 
 func (d *BlobStoreListSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to get a list with all Blob Stores.",
+		MarkdownDescription: "Use this data source to get a list with all Blob Stores, optionally filtered by type, name or quota status.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Used to identify data source at nexus",
 				Computed:    true,
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return blob stores of this type. Possible values: `File`, `S3`, `Group` or `Azure`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("File", "S3", "Group", "Azure"),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return blob stores whose name matches this regular expression",
+				Optional:    true,
+				Validators: []validator.String{
+					tfutil.RegexValidator{},
+				},
+			},
+			"quota_status": schema.StringAttribute{
+				MarkdownDescription: "Only return blob stores with this soft quota status. Possible values: `NONE` (no soft quota), `OK` or `VIOLATION`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(QuotaStatusNone, QuotaStatusOK, QuotaStatusViolation),
+				},
+			},
 			"items": schema.ListNestedAttribute{
-				MarkdownDescription: "A List of all Blob Stores",
+				MarkdownDescription: "A List of all Blob Stores matching the filters",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -57,6 +100,31 @@ func (d *BlobStoreListSource) Schema(ctx context.Context, req datasource.SchemaR
 							Description: "The type of current blob store",
 							Computed:    true,
 						},
+						"unavailable": schema.BoolAttribute{
+							Description: "Whether the blob store is currently unavailable",
+							Computed:    true,
+						},
+						"blob_count": schema.Int64Attribute{
+							Description: "Count of blobs",
+							Computed:    true,
+						},
+						"total_size_in_bytes": schema.Int64Attribute{
+							Description: "The total size of the blobstore in Bytes",
+							Computed:    true,
+						},
+						"available_space_in_bytes": schema.Int64Attribute{
+							Description: "Available space in Bytes",
+							Computed:    true,
+						},
+						"soft_quota": softQuotaDataSourceSchema(),
+						"quota_status": schema.StringAttribute{
+							MarkdownDescription: "The soft quota status. Possible values: `NONE` (no soft quota), `OK` or `VIOLATION`",
+							Computed:            true,
+						},
+						"quota_message": schema.StringAttribute{
+							Description: "The message nexus reports for the soft quota status",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -68,11 +136,11 @@ func (d *BlobStoreListSource) Configure(ctx context.Context, req datasource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -81,20 +149,67 @@ func (d *BlobStoreListSource) Configure(ctx context.Context, req datasource.Conf
 
 func (d *BlobStoreListSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	var state, newState BlobStoreListSourceModel
+	var state BlobStoreListSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
 	blobStoreList, err := d.client.BlobStore.List()
 	if err != nil {
 		resp.Diagnostics.AddError("Get blobStore list failed", err.Error())
 		return
 	}
+	newState := BlobStoreListSourceModel{
+		Id:          types.StringValue("blobstores"),
+		Type:        state.Type,
+		NameRegex:   state.NameRegex,
+		QuotaStatus: state.QuotaStatus,
+		Items:       []*BlobStoreListSourceItemModel{},
+	}
 	for _, item := range blobStoreList {
+		if !state.Type.IsNull() && !strings.EqualFold(state.Type.ValueString(), item.Type) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+			continue
+		}
+
+		quotaStatus, quotaMessage := QuotaStatusNone, ""
+		if item.SoftQuota != nil {
+			status, err := getBlobstoreQuotaStatus(d.client, item.Name)
+			if err != nil {
+				resp.Diagnostics.AddError("Get blobStore quota status failed", err.Error())
+				return
+			}
+			quotaStatus, quotaMessage = QuotaStatusOK, status.Message
+			if status.IsViolation {
+				quotaStatus = QuotaStatusViolation
+			}
+		}
+		if !state.QuotaStatus.IsNull() && state.QuotaStatus.ValueString() != quotaStatus {
+			continue
+		}
+
 		newState.Items = append(newState.Items, &BlobStoreListSourceItemModel{
-			Name: types.StringValue(item.Name),
-			Type: types.StringValue(item.Type),
+			Name:                  types.StringValue(item.Name),
+			Type:                  types.StringValue(item.Type),
+			Unavailable:           types.BoolValue(item.Unavailable),
+			BlobCount:             types.Int64Value(int64(item.BlobCount)),
+			TotalSizeInBytes:      types.Int64Value(int64(item.TotalSizeInBytes)),
+			AvailableSpaceInBytes: types.Int64Value(int64(item.AvailableSpaceInBytes)),
+			SoftQuota:             flattenSoftQuota(item.SoftQuota, true),
+			QuotaStatus:           types.StringValue(quotaStatus),
+			QuotaMessage:          tfutil.StringValueOrNull(quotaMessage),
 		})
 	}
 
@@ -0,0 +1,144 @@
+package blobstore_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestDataSourceBlobstoreList(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	blobstores := server.ProviderConfig() + `
+resource "nexus_blobstore_file" "plain" {
+  name = "list-plain"
+}
+
+resource "nexus_blobstore_file" "used" {
+  name = "list-used"
+
+  soft_quota = {
+    limit = "1MB"
+    type  = "spaceUsedQuota"
+  }
+}
+
+resource "nexus_blobstore_s3" "remaining" {
+  name = "list-remaining"
+
+  soft_quota = {
+    limit = "1GB"
+    type  = "spaceRemainingQuota"
+  }
+
+  bucket_configuration = {
+    bucket = {
+      name       = "nexus"
+      region     = "eu-central-1"
+      expiration = 3
+    }
+  }
+}
+`
+	filters := map[string]string{
+		"all":       ``,
+		"s3":        `type = "S3"`,
+		"file":      `type = "file"`,
+		"group":     `type = "Group"`,
+		"regex":     `name_regex = "^list-(plain|remaining)$"`,
+		"none":      `quota_status = "NONE"`,
+		"ok":        `quota_status = "OK"`,
+		"violation": `quota_status = "VIOLATION"`,
+		"combined":  "type = \"File\"\n  quota_status = \"VIOLATION\"\n  name_regex = \"used\"",
+	}
+	dataSources := ""
+	for name, filter := range filters {
+		dataSources += `
+data "nexus_blobstore_list" "` + name + `" {
+  ` + filter + `
+
+  depends_on = [nexus_blobstore_file.plain, nexus_blobstore_file.used, nexus_blobstore_s3.remaining]
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: blobstores,
+			},
+			{
+				// 2MB of blobs exceed the soft quota of list-used.
+				PreConfig: func() {
+					server.SetBlobstoreUsage("list-used", 20, 2000000)
+				},
+				Config: blobstores + dataSources,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.#", "3"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.0.name", "list-plain"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.1.name", "list-remaining"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.1.soft_quota.limit", "1GB"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.1.soft_quota.limit_bytes", "1000000000"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.2.blob_count", "20"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.all", "items.2.total_size_in_bytes", "2000000"),
+
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.s3", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.s3", "items.0.name", "list-remaining"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.s3", "items.0.type", "S3"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.file", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.group", "items.#", "0"),
+
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.regex", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.regex", "items.0.name", "list-plain"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.regex", "items.1.name", "list-remaining"),
+
+					// A blob store without soft quota has the status NONE.
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.none", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.none", "items.0.name", "list-plain"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.none", "items.0.quota_status", "NONE"),
+					resource.TestCheckNoResourceAttr("data.nexus_blobstore_list.none", "items.0.soft_quota.type"),
+					resource.TestCheckNoResourceAttr("data.nexus_blobstore_list.none", "items.0.quota_message"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.ok", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.ok", "items.0.name", "list-remaining"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.ok", "items.0.quota_message", "Blob store list-remaining is not violating its quota"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.violation", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.violation", "items.0.name", "list-used"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.violation", "items.0.quota_status", "VIOLATION"),
+
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.combined", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_list.combined", "items.0.name", "list-used"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceBlobstoreListInvalidFilters(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for filter, want := range map[string]string{
+		`type = "Nfs"`:            `Invalid Attribute Value Match`,
+		`name_regex = "list-(("`:  `Invalid Regular Expression`,
+		`quota_status = "unused"`: `Invalid Attribute Value Match`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: server.ProviderConfig() + `
+data "nexus_blobstore_list" "test" {
+  ` + filter + `
+}
+`,
+						ExpectError: regexp.MustCompile(want),
+					},
+				},
+			})
+		})
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
 )
 
 var _ datasource.DataSource = &BlobStoreS3Source{}
@@ -18,7 +20,7 @@ func NewBlobStoreS3Source() datasource.DataSource {
 }
 
 type BlobStoreS3Source struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type BlobStoreS3SourceModel struct {
@@ -32,16 +34,17 @@ type BlobStoreS3SourceModel struct {
 }
 
 type advancedBucketConnectionModel struct {
-	Endpoint       types.String `tfsdk:"endpoint"`
-	ForcePathStyle types.Bool   `tfsdk:"force_path_style"`
-	SignerType     types.String `tfsdk:"signer_type"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	ForcePathStyle        types.Bool   `tfsdk:"force_path_style"`
+	MaxConnectionPoolSize types.Int64  `tfsdk:"max_connection_pool_size"`
+	SignerType            types.String `tfsdk:"signer_type"`
 }
 
 type bucketModel struct {
 	Expiration types.Int64  `tfsdk:"expiration"`
 	Name       types.String `tfsdk:"name"`
 	Prefix     types.String `tfsdk:"prefix"`
-	region     types.String `tfsdk:"region"`
+	Region     types.String `tfsdk:"region"`
 }
 
 type bucketSecurityModel struct {
@@ -63,12 +66,12 @@ type bucketConfigurationModel struct {
 }
 
 func (d *BlobStoreS3Source) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_blobstore_file"
+	resp.TypeName = req.ProviderTypeName + "_blobstore_s3"
 }
 
 func (d *BlobStoreS3Source) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Example data source",
+		MarkdownDescription: "Use this data source to get details of an existing Nexus S3 blobstore.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,6 +82,10 @@ func (d *BlobStoreS3Source) Schema(ctx context.Context, req datasource.SchemaReq
 				Description: "Blobstore name",
 				Required:    true,
 			},
+			"available_space_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space in Bytes",
+			},
 			"total_size_in_bytes": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The total size of the blobstore in Bytes",
@@ -87,20 +94,7 @@ func (d *BlobStoreS3Source) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:    true,
 				Description: "Count of blobs",
 			},
-			"soft_quota": schema.SingleNestedAttribute{
-				MarkdownDescription: "Soft quota of the blobstore",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"limit": schema.Int64Attribute{
-						Description: "The limit in Bytes. Minimum value is 1000000",
-						Computed:    true,
-					},
-					"type": schema.StringAttribute{
-						Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
-						Computed:    true,
-					},
-				},
-			},
+			"soft_quota": softQuotaDataSourceSchema(),
 			"bucket_configuration": schema.SingleNestedAttribute{
 				MarkdownDescription: "The S3 bucket configuration.",
 				Computed:            true,
@@ -117,6 +111,10 @@ func (d *BlobStoreS3Source) Schema(ctx context.Context, req datasource.SchemaReq
 								Description: "Setting this flag will result in path-style access being used for all requests.",
 								Computed:    true,
 							},
+							"max_connection_pool_size": schema.Int64Attribute{
+								Description: "Setting this value will override the default connection pool size of Nexus of the s3 client for this blobstore.",
+								Computed:    true,
+							},
 							"signer_type": schema.StringAttribute{
 								Description: "An API signature version which may be required for third party object stores using the S3 API.",
 								Computed:    true,
@@ -178,6 +176,7 @@ func (d *BlobStoreS3Source) Schema(ctx context.Context, req datasource.SchemaReq
 							"encryption_key": schema.StringAttribute{
 								Description: "The encryption key.",
 								Computed:    true,
+								Sensitive:   true,
 							},
 							"encryption_type": schema.StringAttribute{
 								Description: "The type of S3 server side encryption to use.",
@@ -197,12 +196,12 @@ func (d *BlobStoreS3Source) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -219,36 +218,68 @@ func (d *BlobStoreS3Source) Read(ctx context.Context, req datasource.ReadRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	// BlobStoreS3, err := d.client.BlobStore.File.Get(state.Name.ValueString())
-	// if err != nil {
-	// 	resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
-	// 	return
-	// }
-
-	// var genericBlobstoreInformation blobstore.Generic
-	// genericBlobstores, err := d.client.BlobStore.List()
-	// if err != nil {
-	// 	resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
-	// 	return
-	// }
-	// for _, generic := range genericBlobstores {
-	// 	if generic.Name == BlobStoreS3.Name {
-	// 		genericBlobstoreInformation = generic
-	// 	}
-	// }
-
-	// newState = BlobStoreS3SourceModel{
-	// 	Id:                    types.StringValue(BlobStoreS3.Name),
-	// 	Name:                  types.StringValue(BlobStoreS3.Name),
-	// 	Path:                  types.StringValue(BlobStoreS3.Path),
-	// 	AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
-	// 	TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
-	// 	BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
-	// 	SoftQuota: SoftQuota{
-	// 		Limit: types.Int64Value(BlobStoreS3.SoftQuota.Limit),
-	// 		Type:  types.StringValue(BlobStoreS3.SoftQuota.Type),
-	// 	},
-	// }
+	blobStoreS3, err := d.client.BlobStore.S3.Get(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get blobStore s3 failed", err.Error())
+		return
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(d.client, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get blobStore list failed", err.Error())
+		return
+	}
+
+	newState = BlobStoreS3SourceModel{
+		Id:                    types.StringValue(state.Name.ValueString()),
+		Name:                  types.StringValue(state.Name.ValueString()),
+		AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
+		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
+		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
+		BucketConfiguration:   flattenS3BucketConfiguration(blobStoreS3.BucketConfiguration),
+	}
+	newState.SoftQuota = flattenSoftQuota(blobStoreS3.SoftQuota, true)
+
 	tflog.Trace(ctx, "read a BlobStoreS3 data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
+
+// flattenS3BucketConfiguration converts the bucket configuration returned by
+// nexus. Secrets are never returned by the api, so they are left null.
+func flattenS3BucketConfiguration(conf blobstore.S3BucketConfiguration) *bucketConfigurationModel {
+	data := &bucketConfigurationModel{
+		Bucket: &bucketModel{
+			Expiration: types.Int64Value(int64(conf.Bucket.Expiration)),
+			Name:       types.StringValue(conf.Bucket.Name),
+			Prefix:     tfutil.StringValueOrNull(conf.Bucket.Prefix),
+			Region:     types.StringValue(conf.Bucket.Region),
+		},
+	}
+	if sec := conf.BucketSecurity; sec != nil && (sec.AccessKeyID != "" || sec.Role != "") {
+		data.BucketSecurity = &bucketSecurityModel{
+			AccessKeyId:     tfutil.StringValueOrNull(sec.AccessKeyID),
+			Role:            tfutil.StringValueOrNull(sec.Role),
+			SecretAccessKey: types.StringNull(),
+			SessionToken:    types.StringNull(),
+		}
+	}
+	if enc := conf.Encryption; enc != nil && (enc.Key != "" || enc.Type != "") {
+		data.Encryption = &encryptionModel{
+			EncryptionKey:  tfutil.StringValueOrNull(enc.Key),
+			EncryptionType: tfutil.StringValueOrNull(enc.Type),
+		}
+	}
+	if abc := conf.AdvancedBucketConnection; abc != nil &&
+		(abc.Endpoint != "" || abc.SignerType != "" || abc.ForcePathStyle != nil || abc.MaxConnectionPoolSize != nil) {
+		data.AdvancedBucketConnection = &advancedBucketConnectionModel{
+			Endpoint:              tfutil.StringValueOrNull(abc.Endpoint),
+			ForcePathStyle:        types.BoolPointerValue(abc.ForcePathStyle),
+			MaxConnectionPoolSize: types.Int64Null(),
+			SignerType:            tfutil.StringValueOrNull(abc.SignerType),
+		}
+		if abc.MaxConnectionPoolSize != nil {
+			data.AdvancedBucketConnection.MaxConnectionPoolSize = types.Int64Value(int64(*abc.MaxConnectionPoolSize))
+		}
+	}
+	return data
+}
@@ -0,0 +1,381 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// AzureBlobstoreMinVersion is the first Nexus Pro version with the azure blobstore api.
+const AzureBlobstoreMinVersion = "3.30.0"
+
+// ResourceBlobstoreAzure defines the resource implementation.
+type ResourceBlobstoreAzure struct {
+	client *nexusclient.Client
+}
+
+type BlobStoreAzureResourceModel struct {
+	Id                    types.String                   `tfsdk:"id"`
+	Name                  types.String                   `tfsdk:"name"`
+	BlobCount             types.Int64                    `tfsdk:"blob_count"`
+	AvailableSpaceInBytes types.Int64                    `tfsdk:"available_space_in_bytes"`
+	TotalSizeInBytes      types.Int64                    `tfsdk:"total_size_in_bytes"`
+	SoftQuota             *SoftQuotaModel                `tfsdk:"soft_quota"`
+	BucketConfiguration   *azureBucketConfigurationModel `tfsdk:"bucket_configuration"`
+}
+
+type azureBucketConfigurationModel struct {
+	AccountName    types.String              `tfsdk:"account_name"`
+	ContainerName  types.String              `tfsdk:"container_name"`
+	Authentication *azureAuthenticationModel `tfsdk:"authentication"`
+}
+
+type azureAuthenticationModel struct {
+	AuthenticationMethod types.String `tfsdk:"authentication_method"`
+	AccountKey           types.String `tfsdk:"account_key"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ResourceBlobstoreAzure{}
+	_ resource.ResourceWithImportState    = &ResourceBlobstoreAzure{}
+	_ resource.ResourceWithUpgradeState   = &ResourceBlobstoreAzure{}
+	_ resource.ResourceWithModifyPlan     = &ResourceBlobstoreAzure{}
+	_ resource.ResourceWithValidateConfig = &ResourceBlobstoreAzure{}
+)
+
+func NewResourceBlobstoreAzure() resource.Resource {
+	return &ResourceBlobstoreAzure{}
+}
+
+func (r *ResourceBlobstoreAzure) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blobstore_azure"
+}
+
+func (r *ResourceBlobstoreAzure) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: `~> PRO Feature
+
+Use this resource to create a Nexus Azure blobstore.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Blobstore name. Changing the name forces a new blobstore to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"available_space_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total size of the blobstore in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"blob_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Count of blobs",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"soft_quota": softQuotaResourceSchema(),
+			"bucket_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Azure specific configuration details for the Azure object that'll contain the blob store",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"account_name": schema.StringAttribute{
+						Description: "Account name found under Access keys for the storage account",
+						Required:    true,
+					},
+					"container_name": schema.StringAttribute{
+						Description: "The name of an existing container to be used for storage",
+						Required:    true,
+					},
+					"authentication": schema.SingleNestedAttribute{
+						Description: "The Azure specific authentication details",
+						Required:    true,
+						Attributes: map[string]schema.Attribute{
+							"authentication_method": schema.StringAttribute{
+								MarkdownDescription: "The type of Azure authentication to use. Possible values: `ACCOUNTKEY` and `MANAGEDIDENTITY`",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(
+										string(blobstore.AzureAuthenticationMethodAccountKey),
+										string(blobstore.AzureAuthenticationMethodManagedIdentity),
+									),
+								},
+							},
+							"account_key": schema.StringAttribute{
+								MarkdownDescription: "The account key. Required if `authentication_method` is `ACCOUNTKEY`. " +
+									"This value cannot be read from the nexus api, so external changes won't be detected.",
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ResourceBlobstoreAzure) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ResourceBlobstoreAzure) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var method, accountKey types.String
+	authPath := path.Root("bucket_configuration").AtName("authentication")
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authPath.AtName("authentication_method"), &method)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authPath.AtName("account_key"), &accountKey)...)
+	if resp.Diagnostics.HasError() || method.IsUnknown() || accountKey.IsUnknown() {
+		return
+	}
+
+	switch blobstore.AzureAuthenticationMethod(method.ValueString()) {
+	case blobstore.AzureAuthenticationMethodAccountKey:
+		if accountKey.IsNull() || accountKey.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				authPath.AtName("account_key"),
+				"Missing account key",
+				"account_key is required when authentication_method is ACCOUNTKEY.",
+			)
+		}
+	case blobstore.AzureAuthenticationMethodManagedIdentity:
+		if !accountKey.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				authPath.AtName("account_key"),
+				"Unexpected account key",
+				"account_key must not be set when authentication_method is MANAGEDIDENTITY.",
+			)
+		}
+	}
+}
+
+// ModifyPlan checks that the server supports azure blobstores, and warns about
+// destroying a blobstore that is still in use.
+func (r *ResourceBlobstoreAzure) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planBlobstoreDestroy(ctx, r.client, req.State)...)
+		return
+	}
+	if r.client != nil {
+		resp.Diagnostics.Append(r.client.Server.RequirePro("Azure blobstores", AzureBlobstoreMinVersion)...)
+	}
+}
+
+func (r *ResourceBlobstoreAzure) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create BlobStore Azure resource")
+	var plan BlobStoreAzureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bAzure := plan.toBlobstore()
+	err := r.client.BlobStore.Azure.Create(&bAzure)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating blobstore azure", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore azure", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	state.keepSecrets(plan)
+	state.SoftQuota.keepLimit(plan.SoftQuota)
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreAzure) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobStoreAzureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := blobstoreExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore azure", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "blobstore azure not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore azure", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	newState.keepSecrets(state)
+	newState.SoftQuota.keepLimit(state.SoftQuota)
+
+	tflog.Trace(ctx, "read a blobStoreAzure resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceBlobstoreAzure) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BlobStoreAzureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bAzure := plan.toBlobstore()
+	err := r.client.BlobStore.Azure.Update(plan.Id.ValueString(), &bAzure)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating blobstore azure",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore azure", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	state.keepSecrets(plan)
+	state.SoftQuota.keepLimit(plan.SoftQuota)
+	// The plan reuses the usage counters of the state, they are refreshed on the next read.
+	state.AvailableSpaceInBytes = plan.AvailableSpaceInBytes
+	state.TotalSizeInBytes = plan.TotalSizeInBytes
+	state.BlobCount = plan.BlobCount
+
+	tflog.Trace(ctx, "update a blobStoreAzure resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreAzure) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobStoreAzureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteBlobstore(r.client, state.Id.ValueString())...)
+}
+
+func (r *ResourceBlobstoreAzure) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return blobstoreStateUpgraders(ctx, r)
+}
+
+func (r *ResourceBlobstoreAzure) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ResourceBlobstoreAzure) getState(name string) (data BlobStoreAzureResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	bs, err := r.client.BlobStore.Azure.Get(name)
+	if err != nil {
+		return
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(r.client, bs.Name)
+	if err != nil {
+		return
+	}
+
+	data = BlobStoreAzureResourceModel{
+		Id:                    types.StringValue(bs.Name),
+		Name:                  types.StringValue(bs.Name),
+		AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
+		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
+		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
+		BucketConfiguration: &azureBucketConfigurationModel{
+			AccountName:   types.StringValue(bs.BucketConfiguration.AccountName),
+			ContainerName: types.StringValue(bs.BucketConfiguration.ContainerName),
+			Authentication: &azureAuthenticationModel{
+				AuthenticationMethod: types.StringValue(string(bs.BucketConfiguration.Authentication.AuthenticationMethod)),
+				AccountKey:           types.StringNull(),
+			},
+		},
+	}
+	data.SoftQuota = flattenSoftQuota(bs.SoftQuota, false)
+
+	return
+}
+
+// keepSecrets copies the account key from a plan or a previous state, as
+// nexus never returns it.
+func (m *BlobStoreAzureResourceModel) keepSecrets(from BlobStoreAzureResourceModel) {
+	if m.BucketConfiguration == nil || from.BucketConfiguration == nil || from.BucketConfiguration.Authentication == nil {
+		return
+	}
+	m.BucketConfiguration.Authentication.AccountKey = from.BucketConfiguration.Authentication.AccountKey
+}
+
+func (m BlobStoreAzureResourceModel) toBlobstore() blobstore.Azure {
+	bAzure := blobstore.Azure{
+		Name: m.Name.ValueString(),
+	}
+	bAzure.SoftQuota = expandSoftQuota(m.SoftQuota)
+	if m.BucketConfiguration != nil {
+		bAzure.BucketConfiguration = blobstore.AzureBucketConfiguration{
+			AccountName:   m.BucketConfiguration.AccountName.ValueString(),
+			ContainerName: m.BucketConfiguration.ContainerName.ValueString(),
+		}
+		if auth := m.BucketConfiguration.Authentication; auth != nil {
+			bAzure.BucketConfiguration.Authentication = blobstore.AzureBucketConfigurationAuthentication{
+				AuthenticationMethod: blobstore.AzureAuthenticationMethod(auth.AuthenticationMethod.ValueString()),
+				AccountKey:           auth.AccountKey.ValueString(),
+			}
+		}
+	}
+	return bAzure
+}
@@ -0,0 +1,107 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+// azureConfig returns a nexus_blobstore_azure resource and its data source.
+func azureConfig(authentication string) string {
+	return `
+resource "nexus_blobstore_azure" "test" {
+  name = "azure-test"
+
+  bucket_configuration = {
+    account_name   = "nexusaccount"
+    container_name = "nexus"
+
+    authentication = {
+      ` + authentication + `
+    }
+  }
+}
+
+data "nexus_blobstore_azure" "test" {
+  name = nexus_blobstore_azure.test.name
+}
+`
+}
+
+func TestResourceBlobstoreAzure(t *testing.T) {
+	server := acctest.NewServer(t)
+	resourceName := "nexus_blobstore_azure.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + azureConfig(`authentication_method = "ACCOUNTKEY", account_key = "first-key"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.authentication.account_key", "first-key"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_azure.test", "bucket_configuration.authentication.authentication_method", "ACCOUNTKEY"),
+					resource.TestCheckNoResourceAttr("data.nexus_blobstore_azure.test", "bucket_configuration.authentication.account_key"),
+					acctest.CheckBlobstore(server, "azure-test", "bucketConfiguration.authentication.accountKey", "first-key"),
+				),
+			},
+			{
+				Config: server.ProviderConfig() + azureConfig(`authentication_method = "ACCOUNTKEY", account_key = "second-key"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket_configuration.authentication.account_key", "second-key"),
+					acctest.CheckBlobstore(server, "azure-test", "bucketConfiguration.authentication.accountKey", "second-key"),
+				),
+			},
+			{
+				// The account key is never read back from nexus.
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "azure-test",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, state := range states {
+						if key, ok := state.Attributes["bucket_configuration.authentication.account_key"]; ok {
+							return fmt.Errorf("account_key was imported as %q", key)
+						}
+					}
+					return nil
+				},
+			},
+			{
+				Config: server.ProviderConfig() + azureConfig(`authentication_method = "MANAGEDIDENTITY"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "bucket_configuration.authentication.account_key"),
+					acctest.CheckBlobstore(server, "azure-test", "bucketConfiguration.authentication.authenticationMethod", "MANAGEDIDENTITY"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceBlobstoreAzureInvalidAuthentication(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for name, tc := range map[string]struct {
+		authentication string
+		err            string
+	}{
+		"missing account key":    {`authentication_method = "ACCOUNTKEY"`, "Missing account key"},
+		"unexpected account key": {`authentication_method = "MANAGEDIDENTITY", account_key = "key"`, "Unexpected account key"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      server.ProviderConfig() + azureConfig(tc.authentication),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
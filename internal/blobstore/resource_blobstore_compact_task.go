@@ -0,0 +1,520 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// weekdays maps the configured week days to the day numbers used by nexus.
+var weekdays = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// ResourceBlobstoreCompactTask manages an "Admin - Compact blob store" scheduled task.
+type ResourceBlobstoreCompactTask struct {
+	client *nexusclient.Client
+}
+
+type BlobStoreCompactTaskResourceModel struct {
+	Id            types.String       `tfsdk:"id"`
+	Name          types.String       `tfsdk:"name"`
+	BlobstoreName types.String       `tfsdk:"blobstore_name"`
+	Enabled       types.Bool         `tfsdk:"enabled"`
+	AlertEmail    types.String       `tfsdk:"alert_email"`
+	Schedule      *TaskScheduleModel `tfsdk:"schedule"`
+}
+
+type TaskScheduleModel struct {
+	Type           types.String `tfsdk:"type"`
+	CronExpression types.String `tfsdk:"cron_expression"`
+	StartDate      types.String `tfsdk:"start_date"`
+	RecurringDays  types.List   `tfsdk:"recurring_days"`
+	DaysOfMonth    types.List   `tfsdk:"days_of_month"`
+}
+
+// taskScheduleAttributes are the attributes each schedule type requires, the
+// others must not be set.
+var taskScheduleAttributes = map[string][]string{
+	TaskScheduleManual:  {},
+	TaskScheduleOnce:    {"start_date"},
+	TaskScheduleHourly:  {"start_date"},
+	TaskScheduleDaily:   {"start_date"},
+	TaskScheduleWeekly:  {"start_date", "recurring_days"},
+	TaskScheduleMonthly: {"start_date", "days_of_month"},
+	TaskScheduleCron:    {"cron_expression"},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ResourceBlobstoreCompactTask{}
+	_ resource.ResourceWithImportState    = &ResourceBlobstoreCompactTask{}
+	_ resource.ResourceWithValidateConfig = &ResourceBlobstoreCompactTask{}
+	_ resource.ResourceWithModifyPlan     = &ResourceBlobstoreCompactTask{}
+)
+
+func NewResourceBlobstoreCompactTask() resource.Resource {
+	return &ResourceBlobstoreCompactTask{}
+}
+
+func (r *ResourceBlobstoreCompactTask) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blobstore_compact_task"
+}
+
+func (r *ResourceBlobstoreCompactTask) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to schedule the \"Admin - Compact blob store\" task, which removes soft deleted blobs from a blobstore.\n\n" +
+			"~> Requires Nexus " + TaskAPIMinVersion + " or later, older versions cannot create or update tasks through the REST api.\n\n" +
+			"Nexus has no scheduled task for soft quota alerts. Blob stores which violate their soft quota are listed by the " +
+			"`nexus_blobstore_list` data source with `quota_status = \"VIOLATION\"`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the task at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the task",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"blobstore_name": schema.StringAttribute{
+				Description: "The name of the blobstore to compact",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the task is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"alert_email": schema.StringAttribute{
+				Description: "The email address nexus notifies when the task fails",
+				Optional:    true,
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Description: "When the task runs",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The schedule type. Possible values: `manual`, `once`, `hourly`, `daily`, `weekly`, `monthly` or `cron`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								TaskScheduleManual,
+								TaskScheduleOnce,
+								TaskScheduleHourly,
+								TaskScheduleDaily,
+								TaskScheduleWeekly,
+								TaskScheduleMonthly,
+								TaskScheduleCron,
+							),
+						},
+					},
+					"cron_expression": schema.StringAttribute{
+						MarkdownDescription: "The quartz cron expression, such as `0 0 1 * * ?`. Required for `cron` schedules.",
+						Optional:            true,
+					},
+					"start_date": schema.StringAttribute{
+						MarkdownDescription: "The first run in RFC 3339 format, such as `2024-01-01T01:00:00+01:00`. " +
+							"The time of day and offset are used for every run. Required for all schedules but `manual` and `cron`.",
+						Optional: true,
+						Validators: []validator.String{
+							rfc3339Validator{},
+						},
+					},
+					"recurring_days": schema.ListAttribute{
+						MarkdownDescription: "The days the task runs on. Possible values: `SUN`, `MON`, `TUE`, `WED`, `THU`, `FRI` or `SAT`. " +
+							"Required for `weekly` schedules.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+						},
+					},
+					"days_of_month": schema.ListAttribute{
+						MarkdownDescription: fmt.Sprintf("The days of the month the task runs on, from `1` to `31`, "+
+							"or `%d` for the last day of the month. Required for `monthly` schedules.", TaskLastDayOfMonth),
+						ElementType: types.Int64Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueInt64sAre(int64validator.Any(
+								int64validator.Between(1, 31),
+								int64validator.OneOf(TaskLastDayOfMonth),
+							)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ResourceBlobstoreCompactTask) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig checks that the schedule attributes match the schedule type.
+func (r *ResourceBlobstoreCompactTask) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var scheduleObject types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("schedule"), &scheduleObject)...)
+	if resp.Diagnostics.HasError() || scheduleObject.IsNull() || scheduleObject.IsUnknown() {
+		return
+	}
+	var schedule TaskScheduleModel
+	resp.Diagnostics.Append(scheduleObject.As(ctx, &schedule, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || schedule.Type.IsUnknown() {
+		return
+	}
+	schedulePath := path.Root("schedule")
+	required := taskScheduleAttributes[schedule.Type.ValueString()]
+	isNull := map[string]bool{
+		"cron_expression": schedule.CronExpression.IsNull(),
+		"start_date":      schedule.StartDate.IsNull(),
+		"recurring_days":  schedule.RecurringDays.IsNull(),
+		"days_of_month":   schedule.DaysOfMonth.IsNull(),
+	}
+	for _, name := range []string{"cron_expression", "start_date", "recurring_days", "days_of_month"} {
+		isRequired := false
+		for _, requiredName := range required {
+			isRequired = isRequired || requiredName == name
+		}
+		switch {
+		case isRequired && isNull[name]:
+			resp.Diagnostics.AddAttributeError(schedulePath.AtName(name), "Missing schedule attribute",
+				fmt.Sprintf("%s is required for %s schedules.", name, schedule.Type.ValueString()))
+		case !isRequired && !isNull[name]:
+			resp.Diagnostics.AddAttributeError(schedulePath.AtName(name), "Unexpected schedule attribute",
+				fmt.Sprintf("%s must not be set for %s schedules.", name, schedule.Type.ValueString()))
+		}
+	}
+}
+
+// ModifyPlan checks that the server can create and update tasks.
+func (r *ResourceBlobstoreCompactTask) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	resp.Diagnostics.Append(r.client.Server.RequireVersion("Blobstore compact tasks", TaskAPIMinVersion)...)
+}
+
+func (r *ResourceBlobstoreCompactTask) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create BlobStore compact task resource")
+	var plan BlobStoreCompactTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, err := plan.toTask(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid task schedule", err.Error())
+		return
+	}
+	id, err := createTask(r.client, t)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating blobstore compact task",
+			"Could not create, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	plan.Id = types.StringValue(id)
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ResourceBlobstoreCompactTask) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobStoreCompactTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.Id.ValueString() == "" {
+		resp.Diagnostics.AddError("Get blobstore compact task from nexus failed", errors.New("id is nil").Error())
+		return
+	}
+
+	t, err := getTask(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get blobstore compact task from nexus failed", err.Error())
+		return
+	}
+	if t == nil {
+		tflog.Info(ctx, "blobstore compact task no longer exists, removing it from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if t.Type != "" && t.Type != TaskTypeBlobstoreCompact {
+		resp.Diagnostics.AddError("Unexpected task type",
+			fmt.Sprintf("Task %q is a %q task, expected %q.", t.ID, t.Type, TaskTypeBlobstoreCompact))
+		return
+	}
+
+	state.Id = types.StringValue(t.ID)
+	state.Name = types.StringValue(t.Name)
+	if t.Enabled != nil {
+		state.Enabled = types.BoolValue(*t.Enabled)
+	} else if state.Enabled.IsNull() {
+		state.Enabled = types.BoolValue(true)
+	}
+	if t.AlertEmail != "" || !state.AlertEmail.IsNull() {
+		state.AlertEmail = tfutil.StringValueOrNull(t.AlertEmail)
+	}
+	// Older nexus versions only return the task summary, the configured
+	// blobstore and schedule are kept then. Imported tasks have none.
+	if name, ok := t.Properties["blobstoreName"]; ok {
+		state.BlobstoreName = types.StringValue(name)
+	}
+	if t.Frequency != nil {
+		state.Schedule = flattenTaskSchedule(t.Frequency, state.Schedule)
+	}
+	if state.BlobstoreName.IsNull() || state.Schedule == nil {
+		resp.Diagnostics.AddError("Incomplete blobstore compact task",
+			fmt.Sprintf("Nexus did not return the blobstore and the schedule of task %q, so it cannot be imported. "+
+				"Nexus versions which do not return them with the task cannot import tasks, create the task with terraform instead.", t.ID))
+		return
+	}
+
+	tflog.Trace(ctx, "read a blobstore compact task resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreCompactTask) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BlobStoreCompactTaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	t, err := plan.toTask(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid task schedule", err.Error())
+		return
+	}
+	if err := updateTask(r.client, plan.Id.ValueString(), t); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating blobstore compact task",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "update a blobstore compact task resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResourceBlobstoreCompactTask) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobStoreCompactTaskResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteTask(r.client, state.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting blobstore compact task",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+	}
+}
+
+func (r *ResourceBlobstoreCompactTask) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (m *BlobStoreCompactTaskResourceModel) toTask(ctx context.Context) (*task, error) {
+	enabled := m.Enabled.ValueBool()
+	t := &task{
+		Type:       TaskTypeBlobstoreCompact,
+		Name:       m.Name.ValueString(),
+		Enabled:    &enabled,
+		AlertEmail: m.AlertEmail.ValueString(),
+		Properties: map[string]string{
+			"blobstoreName": m.BlobstoreName.ValueString(),
+		},
+	}
+	if t.AlertEmail != "" {
+		t.NotificationCondition = "FAILURE"
+	}
+	frequency, err := expandTaskSchedule(ctx, m.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	t.Frequency = frequency
+	return t, nil
+}
+
+func expandTaskSchedule(ctx context.Context, m *TaskScheduleModel) (*taskFrequency, error) {
+	frequency := &taskFrequency{
+		Schedule:       m.Type.ValueString(),
+		CronExpression: m.CronExpression.ValueString(),
+	}
+	if !m.StartDate.IsNull() {
+		// The value has been validated at plan time.
+		start, err := time.Parse(time.RFC3339, m.StartDate.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		frequency.StartDate = start.Unix()
+		frequency.TimeZoneOffset = start.Format("-07:00")
+	}
+	if !m.RecurringDays.IsNull() {
+		var days []string
+		if diags := m.RecurringDays.ElementsAs(ctx, &days, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read recurring_days")
+		}
+		for _, day := range days {
+			for i, weekday := range weekdays {
+				if day == weekday {
+					frequency.RecurringDays = append(frequency.RecurringDays, i+1)
+				}
+			}
+		}
+	}
+	if !m.DaysOfMonth.IsNull() {
+		var days []int64
+		if diags := m.DaysOfMonth.ElementsAs(ctx, &days, false); diags.HasError() {
+			return nil, fmt.Errorf("could not read days_of_month")
+		}
+		for _, day := range days {
+			frequency.RecurringDays = append(frequency.RecurringDays, int(day))
+		}
+	}
+	return frequency, nil
+}
+
+// flattenTaskSchedule converts the schedule returned by nexus. The configured
+// start_date and recurring_days are kept as long as they describe the same
+// schedule, so that formatting differences do not show up in the plan.
+func flattenTaskSchedule(f *taskFrequency, prior *TaskScheduleModel) *TaskScheduleModel {
+	data := &TaskScheduleModel{
+		Type:           types.StringValue(strings.ToLower(f.Schedule)),
+		CronExpression: tfutil.StringValueOrNull(f.CronExpression),
+		StartDate:      types.StringNull(),
+		RecurringDays:  types.ListNull(types.StringType),
+		DaysOfMonth:    types.ListNull(types.Int64Type),
+	}
+	if f.StartDate != 0 {
+		start := time.Unix(f.StartDate, 0).UTC()
+		if offset, err := time.Parse("-07:00", f.TimeZoneOffset); err == nil {
+			_, seconds := offset.Zone()
+			start = start.In(time.FixedZone("", seconds))
+		}
+		data.StartDate = types.StringValue(start.Format(time.RFC3339))
+		if prior != nil && !prior.StartDate.IsNull() {
+			if priorStart, err := time.Parse(time.RFC3339, prior.StartDate.ValueString()); err == nil && priorStart.Equal(start) {
+				data.StartDate = prior.StartDate
+			}
+		}
+	}
+	switch {
+	case len(f.RecurringDays) > 0 && data.Type.ValueString() == TaskScheduleMonthly:
+		days := make([]attr.Value, 0, len(f.RecurringDays))
+		for _, day := range f.RecurringDays {
+			days = append(days, types.Int64Value(int64(day)))
+		}
+		data.DaysOfMonth = types.ListValueMust(types.Int64Type, days)
+		if prior != nil && sameElements(prior.DaysOfMonth, data.DaysOfMonth) {
+			data.DaysOfMonth = prior.DaysOfMonth
+		}
+	case len(f.RecurringDays) > 0:
+		days := make([]string, 0, len(f.RecurringDays))
+		for _, day := range f.RecurringDays {
+			if day >= 1 && day <= len(weekdays) {
+				days = append(days, weekdays[day-1])
+			}
+		}
+		data.RecurringDays = stringListValue(days)
+		if prior != nil && sameElements(prior.RecurringDays, data.RecurringDays) {
+			data.RecurringDays = prior.RecurringDays
+		}
+	}
+	return data
+}
+
+func stringListValue(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// sameElements reports whether two lists of unique values hold the same
+// values, in any order.
+func sameElements(list types.List, other types.List) bool {
+	if list.IsNull() || list.IsUnknown() || len(list.Elements()) != len(other.Elements()) {
+		return false
+	}
+	for _, e := range other.Elements() {
+		found := false
+		for _, v := range list.Elements() {
+			found = found || v.Equal(e)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return "value must be a date in RFC 3339 format"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Date",
+			fmt.Sprintf("%q is not a RFC 3339 date such as 2024-01-01T01:00:00+01:00: %v", req.ConfigValue.ValueString(), err))
+	}
+}
@@ -0,0 +1,159 @@
+package blobstore_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// compactTaskConfig returns a file blobstore and a compact task with the schedule.
+func compactTaskConfig(server *mocknexus.Server, schedule string) string {
+	return server.ProviderConfig() + `
+resource "nexus_blobstore_file" "test" {
+  name = "compact-test"
+}
+
+resource "nexus_blobstore_compact_task" "test" {
+  name           = "compact-test"
+  blobstore_name = nexus_blobstore_file.test.name
+  schedule       = ` + schedule + `
+}
+`
+}
+
+func TestResourceBlobstoreCompactTask(t *testing.T) {
+	server := acctest.NewServer(t)
+	resourceName := "nexus_blobstore_compact_task.test"
+
+	steps := []resource.TestStep{}
+	for _, tc := range []struct {
+		schedule string
+		check    resource.TestCheckFunc
+	}{
+		{
+			schedule: `{ type = "cron", cron_expression = "0 0 2 * * ?" }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.cronExpression", "0 0 2 * * ?"),
+		},
+		{
+			schedule: `{ type = "manual" }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.schedule", "manual"),
+		},
+		{
+			schedule: `{ type = "once", start_date = "2024-01-01T01:00:00+01:00" }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.startDate", "1704067200"),
+		},
+		{
+			schedule: `{ type = "hourly", start_date = "2024-01-01T00:30:00Z" }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.schedule", "hourly"),
+		},
+		{
+			schedule: `{ type = "daily", start_date = "2024-01-01T03:00:00+01:00" }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.timeZoneOffset", "+01:00"),
+		},
+		{
+			schedule: `{ type = "weekly", start_date = "2024-01-06T03:00:00+01:00", recurring_days = ["SAT", "SUN"] }`,
+			check:    acctest.CheckTask(server, resourceName, "frequency.recurringDays", "[7,1]"),
+		},
+		{
+			schedule: `{ type = "monthly", start_date = "2024-01-01T03:00:00+01:00", days_of_month = [1, 15, 999] }`,
+			check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(resourceName, "schedule.days_of_month.2", "999"),
+				acctest.CheckTask(server, resourceName, "frequency.recurringDays", "[1,15,999]"),
+			),
+		},
+	} {
+		steps = append(steps,
+			resource.TestStep{
+				Config: compactTaskConfig(server, tc.schedule),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckTask(server, resourceName, "properties.blobstoreName", "compact-test"),
+					tc.check,
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps:                    steps,
+	})
+}
+
+func TestResourceBlobstoreCompactTaskInvalidSchedule(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for name, tc := range map[string]struct {
+		schedule string
+		err      string
+	}{
+		"cron without expression":     {`{ type = "cron" }`, "cron_expression is required for cron schedules"},
+		"hourly without start date":   {`{ type = "hourly" }`, "start_date is required for hourly schedules"},
+		"monthly without days":        {`{ type = "monthly", start_date = "2024-01-01T03:00:00Z" }`, "days_of_month is required for monthly schedules"},
+		"manual with start date":      {`{ type = "manual", start_date = "2024-01-01T03:00:00Z" }`, "start_date must not be set for manual schedules"},
+		"weekly with days of month":   {`{ type = "weekly", start_date = "2024-01-01T03:00:00Z", recurring_days = ["MON"], days_of_month = [1] }`, "days_of_month must not be set"},
+		"invalid day of month":        {`{ type = "monthly", start_date = "2024-01-01T03:00:00Z", days_of_month = [32] }`, "Invalid Attribute Value"},
+		"unknown schedule type":       {`{ type = "yearly" }`, "Invalid Attribute Value Match"},
+		"start date without timezone": {`{ type = "once", start_date = "2024-01-01 03:00" }`, "Invalid Date"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      compactTaskConfig(server, tc.schedule),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceBlobstoreCompactTaskImportWithoutSchedule(t *testing.T) {
+	server := acctest.NewServer(t)
+	server.LegacyTasks = true
+	config := compactTaskConfig(server, `{ type = "cron", cron_expression = "0 0 2 * * ?" }`)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				// The configured schedule is kept when nexus does not return it.
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("nexus_blobstore_compact_task.test", "schedule.type", "cron"),
+			},
+			{
+				ResourceName: "nexus_blobstore_compact_task.test",
+				ImportState:  true,
+				ExpectError:  regexp.MustCompile(`Nexus did not return the blobstore and the schedule of task`),
+			},
+		},
+	})
+}
+
+func TestResourceBlobstoreCompactTaskRequiresVersion(t *testing.T) {
+	server := acctest.NewServer(t)
+	server.Version = "3.66.0-02"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      compactTaskConfig(server, `{ type = "manual" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Blobstore compact tasks requires Nexus >= 3.67.0`),
+			},
+		},
+	})
+}
@@ -8,15 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
 	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
 )
 
 // ResourceBlobstoreFile defines the resource implementation.
 type ResourceBlobstoreFile struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type BlobStoreFileReourceModel struct {
@@ -31,8 +36,10 @@ type BlobStoreFileReourceModel struct {
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ResourceBlobstoreFile{}
-	_ resource.ResourceWithImportState = &ResourceBlobstoreFile{}
+	_ resource.Resource                 = &ResourceBlobstoreFile{}
+	_ resource.ResourceWithImportState  = &ResourceBlobstoreFile{}
+	_ resource.ResourceWithUpgradeState = &ResourceBlobstoreFile{}
+	_ resource.ResourceWithModifyPlan   = &ResourceBlobstoreFile{}
 )
 
 func NewResourceBlobstoreFile() resource.Resource {
@@ -45,51 +52,59 @@ func (r *ResourceBlobstoreFile) Metadata(ctx context.Context, req resource.Metad
 
 func (r *ResourceBlobstoreFile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Example resource source",
+		Version:             1,
+		MarkdownDescription: "Use this resource to create a Nexus file blobstore.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "Used to identify data source at nexus",
+				Description: "Used to identify resource at nexus",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Blobstore name",
+				Description: "Blobstore name. Changing the name forces a new blobstore to be created.",
 				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"path": schema.StringAttribute{
-				Description: "The path to the blobstore contents",
-				Optional:    true,
-				Computed:    true,
+				Description: "The path to the blobstore contents. This can be an absolute path to anywhere on the system " +
+					"nexus has access to, or a path relative to the sonatype-work directory. Defaults to the blobstore name.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					blobstorePath(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"available_space_in_bytes": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Available space in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"total_size_in_bytes": schema.Int64Attribute{
 				Computed:    true,
 				Description: "The total size of the blobstore in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"blob_count": schema.Int64Attribute{
 				Computed:    true,
 				Description: "Count of blobs",
-			},
-			"soft_quota": schema.SingleNestedAttribute{
-				MarkdownDescription: "Soft quota of the blobstore",
-				Optional:            true,
-				// Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"limit": schema.Int64Attribute{
-						Description: "The limit in Bytes. Minimum value is 1000000",
-						Optional:    true,
-						// Computed:    true,
-					},
-					"type": schema.StringAttribute{
-						Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
-						Optional:    true,
-						// Computed:    true,
-					},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"soft_quota": softQuotaResourceSchema(),
 		},
 	}
 }
@@ -97,7 +112,7 @@ func (r *ResourceBlobstoreFile) Configure(ctx context.Context, req resource.Conf
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
@@ -108,6 +123,13 @@ func (r *ResourceBlobstoreFile) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan warns about destroying a blobstore that is still in use.
+func (r *ResourceBlobstoreFile) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planBlobstoreDestroy(ctx, r.client, req.State)...)
+	}
+}
+
 func (r *ResourceBlobstoreFile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
 	tflog.Debug(ctx, "Create BlobStore File resource")
@@ -126,43 +148,33 @@ func (r *ResourceBlobstoreFile) Create(ctx context.Context, req resource.CreateR
 		Name: plan.Name.ValueString(),
 		Path: bPath,
 	}
-	if plan.SoftQuota != nil {
-		bFile.SoftQuota = &blobstore.SoftQuota{
-			Type:  plan.SoftQuota.Type.ValueString(),
-			Limit: plan.SoftQuota.Limit.ValueInt64() * 1024 * 1024,
-		}
-	}
+	bFile.SoftQuota = expandSoftQuota(plan.SoftQuota)
 	err := r.client.BlobStore.File.Create(&bFile)
 	if err != nil {
-		resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
+		resp.Diagnostics.AddError("Error Creating blobstore file", "Could not create, unexpected error: "+err.Error())
 		return
 	}
 
 	blobStoreFile, err := r.client.BlobStore.File.Get(plan.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
+		resp.Diagnostics.AddError("Error Reading blobstore file", "Could not read, unexpected error: "+err.Error())
 		return
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := r.client.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(r.client, blobStoreFile.Name)
 	if err != nil {
-		resp.Diagnostics.AddError("Get projects msg from harbor failed", err.Error())
+		resp.Diagnostics.AddError("Error Reading blobstore file", "Could not read, unexpected error: "+err.Error())
 		return
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == blobStoreFile.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	plan.Id = types.StringValue(blobStoreFile.Name)
 	plan.Path = types.StringValue(bFile.Path)
 	plan.AvailableSpaceInBytes = types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes))
 	plan.TotalSizeInBytes = types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes))
 	plan.BlobCount = types.Int64Value(int64(genericBlobstoreInformation.BlobCount))
-
-	tflog.Trace(ctx, "read a blobStoreFile data source")
+	softQuota := flattenSoftQuota(blobStoreFile.SoftQuota, false)
+	softQuota.keepLimit(plan.SoftQuota)
+	plan.SoftQuota = softQuota
 
 	tflog.Debug(ctx, "created a resource")
 
@@ -181,15 +193,26 @@ func (r *ResourceBlobstoreFile) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state, err := r.getState(state.Id.ValueString())
+	exists, err := blobstoreExists(r.client, state.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Get blob file data msg from nexus failed", err.Error())
+		resp.Diagnostics.AddError("Error Reading blobstore file", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "blobstore file not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore file", "Could not read, unexpected error: "+err.Error())
 		return
 
 	}
+	newState.SoftQuota.keepLimit(state.SoftQuota)
 
-	tflog.Trace(ctx, "read a blobStoreFile data source")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Trace(ctx, "read a blobStoreFile resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 
 }
 
@@ -209,12 +232,7 @@ func (r *ResourceBlobstoreFile) Update(ctx context.Context, req resource.UpdateR
 	bFile := blobstore.File{
 		Path: bPath,
 	}
-	if plan.SoftQuota != nil {
-		bFile.SoftQuota = &blobstore.SoftQuota{
-			Type:  plan.SoftQuota.Type.ValueString(),
-			Limit: plan.SoftQuota.Limit.ValueInt64() * 1024 * 1024,
-		}
-	}
+	bFile.SoftQuota = expandSoftQuota(plan.SoftQuota)
 	err := r.client.BlobStore.File.Update(plan.Id.ValueString(), &bFile)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -224,14 +242,19 @@ func (r *ResourceBlobstoreFile) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	plan, err = r.getState(plan.Id.ValueString())
+	newState, err := r.getState(plan.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Get blob file data msg from nexus failed", err.Error())
+		resp.Diagnostics.AddError("Error Reading blobstore file", "Could not read, unexpected error: "+err.Error())
 		return
 
 	}
-	tflog.Trace(ctx, "update a blobStoreFile data source")
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	newState.SoftQuota.keepLimit(plan.SoftQuota)
+	// The plan reuses the usage counters of the state, they are refreshed on the next read.
+	newState.AvailableSpaceInBytes = plan.AvailableSpaceInBytes
+	newState.TotalSizeInBytes = plan.TotalSizeInBytes
+	newState.BlobCount = plan.BlobCount
+	tflog.Trace(ctx, "update a blobStoreFile resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
 func (r *ResourceBlobstoreFile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -241,14 +264,11 @@ func (r *ResourceBlobstoreFile) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.BlobStore.Delete(state.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting blobstore file",
-			"Could not delete, unexpected error: "+err.Error(),
-		)
-		return
-	}
+	resp.Diagnostics.Append(deleteBlobstore(r.client, state.Id.ValueString())...)
+}
+
+func (r *ResourceBlobstoreFile) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return blobstoreStateUpgraders(ctx, r)
 }
 
 func (r *ResourceBlobstoreFile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -265,16 +285,10 @@ func (r *ResourceBlobstoreFile) getState(name string) (data BlobStoreFileReource
 		return
 	}
 
-	var genericBlobstoreInformation blobstore.Generic
-	genericBlobstores, err := r.client.BlobStore.List()
+	genericBlobstoreInformation, err := getGenericBlobstore(r.client, blobStoreFile.Name)
 	if err != nil {
 		return
 	}
-	for _, generic := range genericBlobstores {
-		if generic.Name == blobStoreFile.Name {
-			genericBlobstoreInformation = generic
-		}
-	}
 
 	data = BlobStoreFileReourceModel{
 		Id:                    types.StringValue(blobStoreFile.Name),
@@ -284,12 +298,7 @@ func (r *ResourceBlobstoreFile) getState(name string) (data BlobStoreFileReource
 		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
 		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
 	}
-	if blobStoreFile.SoftQuota != nil {
-		data.SoftQuota = &SoftQuotaModel{
-			Limit: types.Int64Value(blobStoreFile.SoftQuota.Limit / (1024 * 1024)),
-			Type:  types.StringValue(blobStoreFile.SoftQuota.Type),
-		}
-	}
+	data.SoftQuota = flattenSoftQuota(blobStoreFile.SoftQuota, false)
 
 	return
 
@@ -0,0 +1,183 @@
+package blobstore_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+func TestResourceBlobstoreFile(t *testing.T) {
+	server := acctest.NewServer(t)
+	resourceName := "nexus_blobstore_file.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "nexus_blobstore_file" "test" {
+  name = "file-test"
+
+  soft_quota = {
+    limit = "500MiB"
+    type  = "spaceRemainingQuota"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "file-test"),
+					resource.TestCheckResourceAttr(resourceName, "path", "file-test"),
+					resource.TestCheckResourceAttr(resourceName, "blob_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "available_space_in_bytes", fmt.Sprint(mocknexus.DefaultAvailableSpace)),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.limit", "500MiB"),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.limit_bytes", "524288000"),
+					acctest.CheckBlobstore(server, "file-test", "softQuota.limit", "524288000"),
+				),
+			},
+			{
+				// limit_bytes is planned from limit, not left unknown.
+				Config: server.ProviderConfig() + `
+resource "nexus_blobstore_file" "test" {
+  name = "file-test"
+
+  soft_quota = {
+    limit = "1GiB"
+    type  = "spaceRemainingQuota"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("soft_quota").AtMapKey("limit_bytes"), knownvalue.Int64Exact(1<<30)),
+					},
+				},
+				Check: acctest.CheckBlobstore(server, "file-test", "softQuota.limit", "1073741824"),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "nexus_blobstore_file" "test" {
+  name = "file-test"
+  path = "/nexus-data/file-test"
+
+  soft_quota = {
+    limit_bytes = 1024000000
+    type        = "spaceUsedQuota"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "/nexus-data/file-test"),
+					resource.TestCheckNoResourceAttr(resourceName, "soft_quota.limit"),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.limit_bytes", "1024000000"),
+					resource.TestCheckResourceAttr(resourceName, "soft_quota.type", "spaceUsedQuota"),
+					acctest.CheckBlobstore(server, "file-test", "path", "/nexus-data/file-test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "file-test",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceBlobstoreFileInvalidQuota(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for name, quota := range map[string]string{
+		"below minimum": `limit = "999KB"`,
+		"unknown unit":  `limit = "5 parsecs"`,
+		"without unit":  `limit = "500"`,
+		"both limits":   `limit = "1GiB", limit_bytes = 1073741824`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "nexus_blobstore_file" "test" {
+  name       = "file-test"
+  soft_quota = { %s, type = "spaceUsedQuota" }
+}
+`, quota),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`Invalid|Attribute combination`),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceBlobstoreFilePath(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for _, tc := range []struct {
+		name  string
+		path  string
+		error string
+	}{
+		{name: "absolute", path: "/nexus-data/blobs/../file-test"},
+		{name: "windows absolute", path: `C:\\blobs\\..\\file-test`},
+		{name: "relative", path: "blobs/file-test"},
+		{name: "relative with dots in a name", path: "blobs/..file-test"},
+		{name: "relative leaving sonatype-work", path: "../file-test", error: `must\s+not\s+contain\s+'\.\.'`},
+		{name: "relative leaving sonatype-work later", path: "blobs/../../file-test", error: `must\s+not\s+contain\s+'\.\.'`},
+		{name: "relative with backslashes", path: `blobs\\..\\file-test`, error: `must\s+not\s+contain\s+'\.\.'`},
+		{name: "whitespace", path: " blobs", error: `must\s+not\s+start\s+or\s+end\s+with\s+whitespace`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			step := resource.TestStep{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "nexus_blobstore_file" "test" {
+  name = "file-test"
+  path = "%s"
+}
+`, tc.path),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			}
+			if tc.error != "" {
+				step.ExpectError = regexp.MustCompile(tc.error)
+			}
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps:                    []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+func TestResourceBlobstoreFileName(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for _, name := range []string{"_file-test", ".file-test", "file test"} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: server.ProviderConfig() + fmt.Sprintf(`
+resource "nexus_blobstore_file" "test" {
+  name = %q
+}
+`, name),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`must\s+start\s+with\s+a\s+letter`),
+					},
+				},
+			})
+		})
+	}
+}
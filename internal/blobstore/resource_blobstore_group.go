@@ -0,0 +1,391 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// GroupBlobstoreMinVersion is the first Nexus Pro version with the group blobstore api.
+const GroupBlobstoreMinVersion = "3.29.0"
+
+// ResourceBlobstoreGroup defines the resource implementation.
+type ResourceBlobstoreGroup struct {
+	client *nexusclient.Client
+}
+
+type BlobStoreGroupResourceModel struct {
+	Id                    types.String    `tfsdk:"id"`
+	Name                  types.String    `tfsdk:"name"`
+	AvailableSpaceInBytes types.Int64     `tfsdk:"available_space_in_bytes"`
+	BlobCount             types.Int64     `tfsdk:"blob_count"`
+	FillPolicy            types.String    `tfsdk:"fill_policy"`
+	Members               []types.String  `tfsdk:"members"`
+	TotalSizeInBytes      types.Int64     `tfsdk:"total_size_in_bytes"`
+	SoftQuota             *SoftQuotaModel `tfsdk:"soft_quota"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                 = &ResourceBlobstoreGroup{}
+	_ resource.ResourceWithImportState  = &ResourceBlobstoreGroup{}
+	_ resource.ResourceWithUpgradeState = &ResourceBlobstoreGroup{}
+	_ resource.ResourceWithModifyPlan   = &ResourceBlobstoreGroup{}
+)
+
+func NewResourceBlobstoreGroup() resource.Resource {
+	return &ResourceBlobstoreGroup{}
+}
+
+func (r *ResourceBlobstoreGroup) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blobstore_group"
+}
+
+func (r *ResourceBlobstoreGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: `~> PRO Feature
+
+Use this resource to create a Nexus group blobstore.`,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Blobstore name. Changing the name forces a new blobstore to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"available_space_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total size of the blobstore in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"blob_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Count of blobs",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"fill_policy": schema.StringAttribute{
+				MarkdownDescription: "The policy how to fill the members. Possible values: `roundRobin` or `writeToFirst`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(blobstore.GroupFillPolicyRoundRobin),
+				Validators: []validator.String{
+					stringvalidator.OneOf(blobstore.GroupFillPolicyRoundRobin, blobstore.GroupFillPolicyWriteToFirst),
+				},
+			},
+			"members": schema.ListAttribute{
+				Description: "List of the names of blob stores that are members of this group. " +
+					"A member can only be removed from the group once it holds no blobs.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"soft_quota": softQuotaResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceBlobstoreGroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan checks that the server supports group blobstores, reports the
+// planned member changes and refuses to remove members that still hold blobs.
+// Destroying the group while it is in use is warned about.
+func (r *ResourceBlobstoreGroup) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planBlobstoreDestroy(ctx, r.client, req.State)...)
+		return
+	}
+	if r.client == nil {
+		return
+	}
+	resp.Diagnostics.Append(r.client.Server.RequirePro("Group blobstores", GroupBlobstoreMinVersion)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+	var name types.String
+	var planMembers, stateMembers []types.String
+	var members types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsUnknown() || members.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(members.ElementsAs(ctx, &planMembers, false)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("members"), &stateMembers)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, member := range planMembers {
+		if member.IsUnknown() {
+			return
+		}
+	}
+
+	added, removed := diffMembers(stateMembers, planMembers)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("members"),
+		"Blobstore group members change",
+		fmt.Sprintf("Members of blobstore group %q will change.\n  added:   [%s]\n  removed: [%s]",
+			name.ValueString(), strings.Join(added, ", "), strings.Join(removed, ", ")),
+	)
+	resp.Diagnostics.Append(r.checkRemovableMembers(removed)...)
+}
+
+func (r *ResourceBlobstoreGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create BlobStore Group resource")
+	var plan BlobStoreGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bGroup := plan.toBlobstore()
+	err := r.client.BlobStore.Group.Create(&bGroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating blobstore group", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore group", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	state.SoftQuota.keepLimit(plan.SoftQuota)
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobStoreGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := blobstoreExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore group", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "blobstore group not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore group", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	newState.SoftQuota.keepLimit(state.SoftQuota)
+
+	tflog.Trace(ctx, "read a blobStoreGroup resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceBlobstoreGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BlobStoreGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The members may have received blobs since the plan was made.
+	_, removed := diffMembers(state.Members, plan.Members)
+	resp.Diagnostics.Append(r.checkRemovableMembers(removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bGroup := plan.toBlobstore()
+	err := r.client.BlobStore.Group.Update(state.Id.ValueString(), &bGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating blobstore group",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	newState, err := r.getState(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore group", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	newState.SoftQuota.keepLimit(plan.SoftQuota)
+	// The plan reuses the usage counters of the state, they are refreshed on the next read.
+	newState.AvailableSpaceInBytes = plan.AvailableSpaceInBytes
+	newState.TotalSizeInBytes = plan.TotalSizeInBytes
+	newState.BlobCount = plan.BlobCount
+
+	tflog.Trace(ctx, "update a blobStoreGroup resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceBlobstoreGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobStoreGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteBlobstore(r.client, state.Id.ValueString())...)
+}
+
+func (r *ResourceBlobstoreGroup) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return blobstoreStateUpgraders(ctx, r)
+}
+
+func (r *ResourceBlobstoreGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// checkRemovableMembers returns an error for every member which still holds blobs.
+func (r *ResourceBlobstoreGroup) checkRemovableMembers(members []string) (diags diag.Diagnostics) {
+	for _, member := range members {
+		generic, err := getGenericBlobstore(r.client, member)
+		if err != nil {
+			diags.AddError("Get blobStore list failed", err.Error())
+			return
+		}
+		if generic.BlobCount > 0 {
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Blobstore group member is not empty",
+				fmt.Sprintf("Blobstore %q still holds %d blobs and cannot be removed from the group. "+
+					"Move its content to the remaining members first.", member, generic.BlobCount),
+			)
+		}
+	}
+	return
+}
+
+func (r *ResourceBlobstoreGroup) getState(name string) (data BlobStoreGroupResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	bs, err := r.client.BlobStore.Group.Get(name)
+	if err != nil {
+		return
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(r.client, bs.Name)
+	if err != nil {
+		return
+	}
+
+	members := []types.String{}
+	for _, member := range bs.Members {
+		members = append(members, types.StringValue(member))
+	}
+	data = BlobStoreGroupResourceModel{
+		Id:                    types.StringValue(bs.Name),
+		Name:                  types.StringValue(bs.Name),
+		AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
+		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
+		FillPolicy:            types.StringValue(bs.FillPolicy),
+		Members:               members,
+		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
+	}
+	data.SoftQuota = flattenSoftQuota(bs.SoftQuota, false)
+
+	return
+}
+
+func (m BlobStoreGroupResourceModel) toBlobstore() blobstore.Group {
+	bGroup := blobstore.Group{
+		Name:       m.Name.ValueString(),
+		FillPolicy: m.FillPolicy.ValueString(),
+		Members:    []string{},
+	}
+	for _, member := range m.Members {
+		bGroup.Members = append(bGroup.Members, member.ValueString())
+	}
+	bGroup.SoftQuota = expandSoftQuota(m.SoftQuota)
+	return bGroup
+}
+
+// diffMembers returns the members only present in new and only present in old.
+func diffMembers(old, new []types.String) (added, removed []string) {
+	oldSet := map[string]bool{}
+	for _, member := range old {
+		oldSet[member.ValueString()] = true
+	}
+	newSet := map[string]bool{}
+	for _, member := range new {
+		newSet[member.ValueString()] = true
+		if !oldSet[member.ValueString()] {
+			added = append(added, member.ValueString())
+		}
+	}
+	for _, member := range old {
+		if !newSet[member.ValueString()] {
+			removed = append(removed, member.ValueString())
+		}
+	}
+	return
+}
@@ -0,0 +1,107 @@
+package blobstore_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceBlobstoreGroup(t *testing.T) {
+	server := acctest.NewServer(t)
+	resourceName := "nexus_blobstore_group.test"
+
+	config := func(fillPolicy string, members string) string {
+		return server.ProviderConfig() + `
+resource "nexus_blobstore_file" "first" {
+  name = "group-first"
+}
+
+resource "nexus_blobstore_file" "second" {
+  name = "group-second"
+}
+
+resource "nexus_blobstore_group" "test" {
+  name        = "group-test"
+  fill_policy = "` + fillPolicy + `"
+  members     = [` + members + `]
+}
+
+data "nexus_blobstore_group" "test" {
+  name = nexus_blobstore_group.test.name
+}
+`
+	}
+	both := `nexus_blobstore_file.first.name, nexus_blobstore_file.second.name`
+	first := `nexus_blobstore_file.first.name`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("roundRobin", first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fill_policy", "roundRobin"),
+					acctest.CheckBlobstore(server, "group-test", "members", `["group-first"]`),
+				),
+			},
+			{
+				Config: config("writeToFirst", both),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "members.1", "group-second"),
+					resource.TestCheckResourceAttr("data.nexus_blobstore_group.test", "fill_policy", "writeToFirst"),
+					acctest.CheckBlobstore(server, "group-test", "members", `["group-first","group-second"]`),
+				),
+			},
+			{
+				// A member which holds blobs must not be removed.
+				PreConfig: func() {
+					server.SetBlobstoreUsage("group-second", 5, 1024)
+				},
+				Config:      config("writeToFirst", first),
+				ExpectError: regexp.MustCompile(`Blobstore "group-second" still holds 5 blobs`),
+			},
+			{
+				PreConfig: func() {
+					server.SetBlobstoreUsage("group-second", 0, 0)
+				},
+				Config: config("writeToFirst", first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					acctest.CheckBlobstore(server, "group-test", "members", `["group-first"]`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "group-test",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceBlobstoreGroupRequiresPro(t *testing.T) {
+	server := acctest.NewServer(t)
+	server.Edition = "OSS"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "nexus_blobstore_group" "test" {
+  name    = "group-test"
+  members = ["default"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Group blobstores requires Nexus Pro >= 3.29.0`),
+			},
+		},
+	})
+}
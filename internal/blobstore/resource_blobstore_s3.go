@@ -0,0 +1,416 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceBlobstoreS3 defines the resource implementation.
+type ResourceBlobstoreS3 struct {
+	client *nexusclient.Client
+}
+
+type BlobStoreS3ResourceModel struct {
+	Id                    types.String              `tfsdk:"id"`
+	Name                  types.String              `tfsdk:"name"`
+	BlobCount             types.Int64               `tfsdk:"blob_count"`
+	AvailableSpaceInBytes types.Int64               `tfsdk:"available_space_in_bytes"`
+	TotalSizeInBytes      types.Int64               `tfsdk:"total_size_in_bytes"`
+	SoftQuota             *SoftQuotaModel           `tfsdk:"soft_quota"`
+	BucketConfiguration   *bucketConfigurationModel `tfsdk:"bucket_configuration"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                 = &ResourceBlobstoreS3{}
+	_ resource.ResourceWithImportState  = &ResourceBlobstoreS3{}
+	_ resource.ResourceWithUpgradeState = &ResourceBlobstoreS3{}
+	_ resource.ResourceWithModifyPlan   = &ResourceBlobstoreS3{}
+)
+
+func NewResourceBlobstoreS3() resource.Resource {
+	return &ResourceBlobstoreS3{}
+}
+
+func (r *ResourceBlobstoreS3) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blobstore_s3"
+}
+
+func (r *ResourceBlobstoreS3) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Use this resource to create a Nexus S3 blobstore.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Blobstore name. Changing the name forces a new blobstore to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"available_space_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Available space in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"total_size_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total size of the blobstore in Bytes",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"blob_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Count of blobs",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"soft_quota": softQuotaResourceSchema(),
+			"bucket_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "The S3 bucket configuration.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"advanced_bucket_connection": schema.SingleNestedAttribute{
+						Description: "Additional connection configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"endpoint": schema.StringAttribute{
+								Description: "A custom endpoint URL for third party object stores using the S3 API.",
+								Optional:    true,
+							},
+							"force_path_style": schema.BoolAttribute{
+								Description: "Setting this flag will result in path-style access being used for all requests.",
+								Optional:    true,
+							},
+							"max_connection_pool_size": schema.Int64Attribute{
+								Description: "Setting this value will override the default connection pool size of Nexus of the s3 client for this blobstore.",
+								Optional:    true,
+							},
+							"signer_type": schema.StringAttribute{
+								Description: "An API signature version which may be required for third party object stores using the S3 API.",
+								Optional:    true,
+							},
+						},
+					},
+					"bucket": schema.SingleNestedAttribute{
+						Description: "The S3 bucket configuration",
+						Required:    true,
+						Attributes: map[string]schema.Attribute{
+							"region": schema.StringAttribute{
+								Description: "The AWS region to create a new S3 bucket in or an existing S3 bucket's region",
+								Required:    true,
+							},
+							"name": schema.StringAttribute{
+								Description: "The name of the S3 bucket",
+								Required:    true,
+							},
+							"prefix": schema.StringAttribute{
+								Description: "The S3 blob store (i.e S3 object) key prefix",
+								Optional:    true,
+							},
+							"expiration": schema.Int64Attribute{
+								Description: "How many days until deleted blobs are finally removed from the S3 bucket (-1 to disable)",
+								Required:    true,
+							},
+						},
+					},
+					"bucket_security": schema.SingleNestedAttribute{
+						Description: "Additional security configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"access_key_id": schema.StringAttribute{
+								Description: "An IAM access key ID for granting access to the S3 bucket",
+								Optional:    true,
+							},
+							"secret_access_key": schema.StringAttribute{
+								Description: "The secret access key associated with the specified IAM access key ID. " +
+									"This value cannot be read from the nexus api, so external changes won't be detected.",
+								Optional:  true,
+								Sensitive: true,
+							},
+							"role": schema.StringAttribute{
+								Description: "An IAM role to assume in order to access the S3 bucket",
+								Optional:    true,
+							},
+							"session_token": schema.StringAttribute{
+								Description: "An AWS STS session token associated with temporary security credentials which grant access to the S3 bucket. " +
+									"This value cannot be read from the nexus api, so external changes won't be detected.",
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+					},
+					"encryption": schema.SingleNestedAttribute{
+						Description: "Additional bucket encryption configurations",
+						Optional:    true,
+						Attributes: map[string]schema.Attribute{
+							"encryption_key": schema.StringAttribute{
+								Description: "The encryption key.",
+								Optional:    true,
+								Sensitive:   true,
+							},
+							"encryption_type": schema.StringAttribute{
+								Description: "The type of S3 server side encryption to use.",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ResourceBlobstoreS3) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan warns about destroying a blobstore that is still in use.
+func (r *ResourceBlobstoreS3) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(planBlobstoreDestroy(ctx, r.client, req.State)...)
+	}
+}
+
+func (r *ResourceBlobstoreS3) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create BlobStore S3 resource")
+	var plan BlobStoreS3ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bS3 := plan.toBlobstore()
+	err := r.client.BlobStore.S3.Create(&bS3)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating blobstore s3", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore s3", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	state.keepSecrets(plan)
+	state.SoftQuota.keepLimit(plan.SoftQuota)
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreS3) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobStoreS3ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := blobstoreExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore s3", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "blobstore s3 not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore s3", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	newState.keepSecrets(state)
+	newState.SoftQuota.keepLimit(state.SoftQuota)
+
+	tflog.Trace(ctx, "read a blobStoreS3 resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceBlobstoreS3) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan BlobStoreS3ResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bS3 := plan.toBlobstore()
+	err := r.client.BlobStore.S3.Update(plan.Id.ValueString(), &bS3)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating blobstore s3",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading blobstore s3", "Could not read, unexpected error: "+err.Error())
+		return
+	}
+	state.keepSecrets(plan)
+	state.SoftQuota.keepLimit(plan.SoftQuota)
+	// The plan reuses the usage counters of the state, they are refreshed on the next read.
+	state.AvailableSpaceInBytes = plan.AvailableSpaceInBytes
+	state.TotalSizeInBytes = plan.TotalSizeInBytes
+	state.BlobCount = plan.BlobCount
+
+	tflog.Trace(ctx, "update a blobStoreS3 resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceBlobstoreS3) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobStoreS3ResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteBlobstore(r.client, state.Id.ValueString())...)
+}
+
+func (r *ResourceBlobstoreS3) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return blobstoreStateUpgraders(ctx, r)
+}
+
+func (r *ResourceBlobstoreS3) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ResourceBlobstoreS3) getState(name string) (data BlobStoreS3ResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	blobStoreS3, err := r.client.BlobStore.S3.Get(name)
+	if err != nil {
+		return
+	}
+
+	genericBlobstoreInformation, err := getGenericBlobstore(r.client, name)
+	if err != nil {
+		return
+	}
+
+	data = BlobStoreS3ResourceModel{
+		Id:                    types.StringValue(name),
+		Name:                  types.StringValue(name),
+		AvailableSpaceInBytes: types.Int64Value(int64(genericBlobstoreInformation.AvailableSpaceInBytes)),
+		TotalSizeInBytes:      types.Int64Value(int64(genericBlobstoreInformation.TotalSizeInBytes)),
+		BlobCount:             types.Int64Value(int64(genericBlobstoreInformation.BlobCount)),
+		BucketConfiguration:   flattenS3BucketConfiguration(blobStoreS3.BucketConfiguration),
+	}
+	data.SoftQuota = flattenSoftQuota(blobStoreS3.SoftQuota, false)
+
+	return
+}
+
+// keepSecrets copies the write-only credentials from a plan or a previous
+// state, as nexus never returns them.
+func (m *BlobStoreS3ResourceModel) keepSecrets(from BlobStoreS3ResourceModel) {
+	if m.BucketConfiguration == nil || from.BucketConfiguration == nil {
+		return
+	}
+	if from.BucketConfiguration.BucketSecurity != nil {
+		if m.BucketConfiguration.BucketSecurity == nil {
+			m.BucketConfiguration.BucketSecurity = &bucketSecurityModel{
+				AccessKeyId: types.StringNull(),
+				Role:        types.StringNull(),
+			}
+		}
+		m.BucketConfiguration.BucketSecurity.SecretAccessKey = from.BucketConfiguration.BucketSecurity.SecretAccessKey
+		m.BucketConfiguration.BucketSecurity.SessionToken = from.BucketConfiguration.BucketSecurity.SessionToken
+	}
+	if from.BucketConfiguration.Encryption != nil && m.BucketConfiguration.Encryption != nil {
+		m.BucketConfiguration.Encryption.EncryptionKey = from.BucketConfiguration.Encryption.EncryptionKey
+	}
+}
+
+func (m BlobStoreS3ResourceModel) toBlobstore() blobstore.S3 {
+	bS3 := blobstore.S3{
+		Name: m.Name.ValueString(),
+	}
+	bS3.SoftQuota = expandSoftQuota(m.SoftQuota)
+	if m.BucketConfiguration == nil {
+		return bS3
+	}
+	conf := m.BucketConfiguration
+	if conf.Bucket != nil {
+		bS3.BucketConfiguration.Bucket = blobstore.S3Bucket{
+			Name:       conf.Bucket.Name.ValueString(),
+			Region:     conf.Bucket.Region.ValueString(),
+			Prefix:     conf.Bucket.Prefix.ValueString(),
+			Expiration: int32(conf.Bucket.Expiration.ValueInt64()),
+		}
+	}
+	if conf.BucketSecurity != nil {
+		bS3.BucketConfiguration.BucketSecurity = &blobstore.S3BucketSecurity{
+			AccessKeyID:     conf.BucketSecurity.AccessKeyId.ValueString(),
+			Role:            conf.BucketSecurity.Role.ValueString(),
+			SecretAccessKey: conf.BucketSecurity.SecretAccessKey.ValueString(),
+			SessionToken:    conf.BucketSecurity.SessionToken.ValueString(),
+		}
+	}
+	if conf.Encryption != nil {
+		bS3.BucketConfiguration.Encryption = &blobstore.S3Encryption{
+			Key:  conf.Encryption.EncryptionKey.ValueString(),
+			Type: conf.Encryption.EncryptionType.ValueString(),
+		}
+	}
+	if conf.AdvancedBucketConnection != nil {
+		abc := &blobstore.S3AdvancedBucketConnection{
+			Endpoint:   conf.AdvancedBucketConnection.Endpoint.ValueString(),
+			SignerType: conf.AdvancedBucketConnection.SignerType.ValueString(),
+		}
+		if !conf.AdvancedBucketConnection.ForcePathStyle.IsNull() {
+			abc.ForcePathStyle = conf.AdvancedBucketConnection.ForcePathStyle.ValueBoolPointer()
+		}
+		if !conf.AdvancedBucketConnection.MaxConnectionPoolSize.IsNull() {
+			size := int32(conf.AdvancedBucketConnection.MaxConnectionPoolSize.ValueInt64())
+			abc.MaxConnectionPoolSize = &size
+		}
+		bS3.BucketConfiguration.AdvancedBucketConnection = abc
+	}
+	return bS3
+}
@@ -0,0 +1,334 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const (
+	SoftQuotaTypeSpaceRemaining = "spaceRemainingQuota"
+	SoftQuotaTypeSpaceUsed      = "spaceUsedQuota"
+
+	// SoftQuotaMinLimit is the smallest soft quota limit nexus accepts, in bytes.
+	SoftQuotaMinLimit = 1000000
+)
+
+// SoftQuotaModel is shared by all blob store kinds. The nexus api always works
+// in bytes; limit is a human readable alternative to limit_bytes.
+type SoftQuotaModel struct {
+	Limit      types.String `tfsdk:"limit"`
+	LimitBytes types.Int64  `tfsdk:"limit_bytes"`
+	Type       types.String `tfsdk:"type"`
+}
+
+var byteUnits = map[string]int64{
+	"b":   1,
+	"kb":  1000,
+	"mb":  1000 * 1000,
+	"gb":  1000 * 1000 * 1000,
+	"tb":  1000 * 1000 * 1000 * 1000,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+var byteSizeRegexp = regexp.MustCompile(`^\s*(\d+)\s*([a-zA-Z]*)\s*$`)
+
+// parseByteSize parses sizes such as "500GiB", "2TB" or "1000000B" into bytes.
+func parseByteSize(s string) (int64, error) {
+	m := byteSizeRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("invalid size %q, expected a number followed by a unit such as MiB, GiB, MB or GB", s)
+	}
+	if m[2] == "" {
+		// Before version 1 of the schema the limit was a number of MiB.
+		return 0, fmt.Errorf("invalid size %q, a unit is now required: the limit is no longer a number of MiB. "+
+			"Write %sMiB to keep the previous limit, or set limit_bytes to a number of bytes", s, m[1])
+	}
+	unit, ok := byteUnits[strings.ToLower(m[2])]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q, unknown unit %q", s, m[2])
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %v", s, err)
+	}
+	if n > (1<<63-1)/unit {
+		return 0, fmt.Errorf("invalid size %q, value is too large", s)
+	}
+	return n * unit, nil
+}
+
+// formatByteSize formats bytes with the largest unit that represents them exactly.
+func formatByteSize(n int64) string {
+	for _, unit := range []string{"TiB", "GiB", "MiB", "KiB", "TB", "GB", "MB", "KB"} {
+		size := byteUnits[strings.ToLower(unit)]
+		if n != 0 && n%size == 0 {
+			return fmt.Sprintf("%d%s", n/size, unit)
+		}
+	}
+	return strconv.FormatInt(n, 10) + "B"
+}
+
+func softQuotaResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "Soft quota of the blobstore",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The limit with a unit, such as `500GiB` or `2TB`. "+
+					"Units are `B`, `KB`, `MB`, `GB`, `TB` (powers of 1000) and `KiB`, `MiB`, `GiB`, `TiB` (powers of 1024). "+
+					"Minimum value is %d bytes. Conflicts with `limit_bytes`.", SoftQuotaMinLimit),
+				Optional: true,
+				Validators: []validator.String{
+					byteSizeAtLeast(SoftQuotaMinLimit),
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("limit_bytes")),
+				},
+			},
+			"limit_bytes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The limit in Bytes. Minimum value is %d. Conflicts with `limit`.", SoftQuotaMinLimit),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(SoftQuotaMinLimit),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					limitBytesFromLimit(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type to use. Possible values: `spaceRemainingQuota` or `spaceUsedQuota`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(SoftQuotaTypeSpaceRemaining, SoftQuotaTypeSpaceUsed),
+				},
+			},
+		},
+	}
+}
+
+// softQuotaResourceSchemaV0 is the soft quota of schema version 0, whose
+// limit was a number of MiB.
+func softQuotaResourceSchemaV0() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"limit": schema.Int64Attribute{
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// blobstoreStateUpgraders upgrades the state of a blob store resource from
+// schema version 0, which had a soft quota limit in MiB.
+func blobstoreStateUpgraders(ctx context.Context, r resource.Resource) map[int64]resource.StateUpgrader {
+	var current resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &current)
+	attributes := map[string]schema.Attribute{}
+	for name, attribute := range current.Schema.Attributes {
+		attributes[name] = attribute
+	}
+	attributes["soft_quota"] = softQuotaResourceSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schema.Schema{Attributes: attributes},
+			StateUpgrader: upgradeBlobstoreStateV0,
+		},
+	}
+}
+
+func upgradeBlobstoreStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	stateType := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	var attributes map[string]tftypes.Value
+	if err := req.State.Raw.As(&attributes); err != nil {
+		resp.Diagnostics.AddError("Upgrade blobstore state failed", err.Error())
+		return
+	}
+	softQuota, err := upgradeSoftQuotaV0(attributes["soft_quota"], stateType.AttributeTypes["soft_quota"].(tftypes.Object))
+	if err != nil {
+		resp.Diagnostics.AddError("Upgrade blobstore soft quota failed", err.Error())
+		return
+	}
+	attributes["soft_quota"] = softQuota
+	resp.State.Raw = tftypes.NewValue(stateType, attributes)
+}
+
+// upgradeSoftQuotaV0 converts the limit in MiB into limit_bytes. The limit is
+// left null, the next plan sets it if the configuration uses it.
+func upgradeSoftQuotaV0(v tftypes.Value, softQuotaType tftypes.Object) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(softQuotaType, nil), nil
+	}
+	var quota map[string]tftypes.Value
+	if err := v.As(&quota); err != nil {
+		return tftypes.Value{}, err
+	}
+	var limit *big.Float
+	if err := quota["limit"].As(&limit); err != nil {
+		return tftypes.Value{}, err
+	}
+	limitBytes := tftypes.NewValue(tftypes.Number, nil)
+	if limit != nil {
+		limitBytes = tftypes.NewValue(tftypes.Number, new(big.Float).Mul(limit, big.NewFloat(1<<20)))
+	}
+	return tftypes.NewValue(softQuotaType, map[string]tftypes.Value{
+		"limit":       tftypes.NewValue(tftypes.String, nil),
+		"limit_bytes": limitBytes,
+		"type":        quota["type"],
+	}), nil
+}
+
+func softQuotaDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		MarkdownDescription: "Soft quota of the blobstore",
+		Computed:            true,
+		Attributes: map[string]dschema.Attribute{
+			"limit": dschema.StringAttribute{
+				MarkdownDescription: "The limit with the largest exact unit, such as `500GiB`",
+				Computed:            true,
+			},
+			"limit_bytes": dschema.Int64Attribute{
+				Description: "The limit in Bytes",
+				Computed:    true,
+			},
+			"type": dschema.StringAttribute{
+				Description: "The type to use such as spaceRemainingQuota, or spaceUsedQuota",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// expandSoftQuota converts a planned soft quota into its api representation.
+func expandSoftQuota(m *SoftQuotaModel) *blobstore.SoftQuota {
+	if m == nil {
+		return nil
+	}
+	limit := m.LimitBytes.ValueInt64()
+	if !m.Limit.IsNull() && !m.Limit.IsUnknown() {
+		// The value has been validated at plan time.
+		limit, _ = parseByteSize(m.Limit.ValueString())
+	}
+	return &blobstore.SoftQuota{
+		Type:  m.Type.ValueString(),
+		Limit: limit,
+	}
+}
+
+// flattenSoftQuota converts a soft quota returned by nexus. The limit is
+// formatted for data sources and left null for resources, see keepLimit.
+func flattenSoftQuota(q *blobstore.SoftQuota, formatLimit bool) *SoftQuotaModel {
+	if q == nil {
+		return nil
+	}
+	data := &SoftQuotaModel{
+		Limit:      types.StringNull(),
+		LimitBytes: types.Int64Value(q.Limit),
+		Type:       types.StringValue(q.Type),
+	}
+	if formatLimit {
+		data.Limit = types.StringValue(formatByteSize(q.Limit))
+	}
+	return data
+}
+
+// keepLimit keeps the limit as written in the configuration as long as it
+// still matches the limit reported by nexus.
+func (m *SoftQuotaModel) keepLimit(prior *SoftQuotaModel) {
+	if m == nil || prior == nil || prior.Limit.IsNull() || prior.Limit.IsUnknown() {
+		return
+	}
+	if limit, err := parseByteSize(prior.Limit.ValueString()); err == nil && limit == m.LimitBytes.ValueInt64() {
+		m.Limit = prior.Limit
+		return
+	}
+	m.Limit = types.StringValue(formatByteSize(m.LimitBytes.ValueInt64()))
+}
+
+type byteSizeValidator struct {
+	min int64
+}
+
+// byteSizeAtLeast validates a size with unit and checks it is at least min bytes.
+func byteSizeAtLeast(min int64) validator.String {
+	return byteSizeValidator{min: min}
+}
+
+func (v byteSizeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a size of at least %d bytes", v.min)
+}
+
+func (v byteSizeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v byteSizeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	size, err := parseByteSize(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Size", err.Error())
+		return
+	}
+	if size < v.min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Size",
+			fmt.Sprintf("%q is %d bytes, nexus requires at least %d bytes.", req.ConfigValue.ValueString(), size, v.min),
+		)
+	}
+}
+
+type limitBytesModifier struct{}
+
+// limitBytesFromLimit plans limit_bytes from a known limit, so that it is not
+// shown as known after apply when the configuration uses limit.
+func limitBytesFromLimit() planmodifier.Int64 {
+	return limitBytesModifier{}
+}
+
+func (m limitBytesModifier) Description(ctx context.Context) string {
+	return "limit_bytes is computed from limit when limit is set"
+}
+
+func (m limitBytesModifier) MarkdownDescription(ctx context.Context) string {
+	return "`limit_bytes` is computed from `limit` when `limit` is set"
+}
+
+func (m limitBytesModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+	var limit types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path.ParentPath().AtName("limit"), &limit)...)
+	if resp.Diagnostics.HasError() || limit.IsNull() || limit.IsUnknown() {
+		return
+	}
+	// Invalid sizes are reported by the validator of limit.
+	if size, err := parseByteSize(limit.ValueString()); err == nil {
+		resp.PlanValue = types.Int64Value(size)
+	}
+}
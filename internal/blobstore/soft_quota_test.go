@@ -0,0 +1,106 @@
+package blobstore_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestUpgradeSoftQuotaStateV0(t *testing.T) {
+	ctx := context.Background()
+	server, err := acctest.ProtoV6ProviderFactories["nexus"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		typeName string
+		state    string
+		// withoutQuota is set for states without soft quota.
+		withoutQuota bool
+	}{
+		{
+			typeName:     "nexus_blobstore_file",
+			state:        `{"id":"file","name":"file","path":"file","soft_quota":null}`,
+			withoutQuota: true,
+		},
+		{
+			typeName: "nexus_blobstore_file",
+			state:    `{"id":"file","name":"file","path":"file","blob_count":0,"soft_quota":{"limit":500,"type":"spaceUsedQuota"}}`,
+		},
+		{
+			typeName: "nexus_blobstore_s3",
+			state: `{"id":"s3","name":"s3","soft_quota":{"limit":500,"type":"spaceUsedQuota"},` +
+				`"bucket_configuration":{"bucket":{"name":"nexus","region":"eu-central-1","expiration":3}}}`,
+		},
+		{
+			typeName: "nexus_blobstore_group",
+			state:    `{"id":"group","name":"group","fill_policy":"roundRobin","members":["file"],"soft_quota":{"limit":500,"type":"spaceUsedQuota"}}`,
+		},
+		{
+			typeName: "nexus_blobstore_azure",
+			state: `{"id":"azure","name":"azure","soft_quota":{"limit":500,"type":"spaceUsedQuota"},` +
+				`"bucket_configuration":{"account_name":"nexus","container_name":"nexus","authentication":{"authentication_method":"ACCOUNTKEY","account_key":"key"}}}`,
+		},
+	} {
+		t.Run(tc.typeName, func(t *testing.T) {
+			resourceSchema, ok := schemas.ResourceSchemas[tc.typeName]
+			if !ok {
+				t.Fatalf("no schema for %s", tc.typeName)
+			}
+			if resourceSchema.Version != 1 {
+				t.Errorf("got schema version %d, want 1", resourceSchema.Version)
+			}
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: tc.typeName,
+				Version:  0,
+				RawState: &tfprotov6.RawState{JSON: []byte(tc.state)},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range resp.Diagnostics {
+				t.Fatalf("%s: %s", d.Summary, d.Detail)
+			}
+			state, err := resp.UpgradedState.Unmarshal(resourceSchema.ValueType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var attributes map[string]tftypes.Value
+			var quota map[string]tftypes.Value
+			var limit string
+			var limitBytes *big.Float
+			if err := state.As(&attributes); err != nil {
+				t.Fatal(err)
+			}
+			if tc.withoutQuota {
+				if !attributes["soft_quota"].IsNull() {
+					t.Errorf("got soft_quota %s, want null", attributes["soft_quota"])
+				}
+				return
+			}
+			if err := attributes["soft_quota"].As(&quota); err != nil {
+				t.Fatal(err)
+			}
+			if !quota["limit"].IsNull() {
+				_ = quota["limit"].As(&limit)
+				t.Errorf("got limit %q, want null", limit)
+			}
+			if err := quota["limit_bytes"].As(&limitBytes); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := limitBytes.Int64(); got != 500*1024*1024 {
+				t.Errorf("got limit_bytes %d, want %d", got, 500*1024*1024)
+			}
+		})
+	}
+}
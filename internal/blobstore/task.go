@@ -0,0 +1,120 @@
+package blobstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+const (
+	tasksAPIEndpoint = nexusclient.BasePath + "v1/tasks"
+
+	// TaskAPIMinVersion is the first nexus version which creates and updates
+	// tasks through POST and PUT of the tasks api.
+	TaskAPIMinVersion = "3.67.0"
+
+	TaskTypeBlobstoreCompact = "blobstore.compact"
+
+	TaskScheduleManual  = "manual"
+	TaskScheduleOnce    = "once"
+	TaskScheduleHourly  = "hourly"
+	TaskScheduleDaily   = "daily"
+	TaskScheduleWeekly  = "weekly"
+	TaskScheduleMonthly = "monthly"
+	TaskScheduleCron    = "cron"
+
+	// TaskLastDayOfMonth is the day of monthly schedules nexus uses for the
+	// last day of every month.
+	TaskLastDayOfMonth = 999
+)
+
+// task is the scheduled task representation of the nexus tasks api.
+// Older nexus versions do not return frequency and properties when a task is
+// read, so both are optional.
+type task struct {
+	ID                    string            `json:"id,omitempty"`
+	Type                  string            `json:"type"`
+	Name                  string            `json:"name"`
+	Enabled               *bool             `json:"enabled,omitempty"`
+	AlertEmail            string            `json:"alertEmail,omitempty"`
+	NotificationCondition string            `json:"notificationCondition,omitempty"`
+	Frequency             *taskFrequency    `json:"frequency,omitempty"`
+	Properties            map[string]string `json:"properties,omitempty"`
+}
+
+type taskFrequency struct {
+	Schedule       string `json:"schedule"`
+	StartDate      int64  `json:"startDate,omitempty"`
+	TimeZoneOffset string `json:"timeZoneOffset,omitempty"`
+	// RecurringDays are week days of weekly schedules, 1 is sunday, and
+	// days of the month of monthly schedules.
+	RecurringDays  []int  `json:"recurringDays,omitempty"`
+	CronExpression string `json:"cronExpression,omitempty"`
+}
+
+func createTask(client *nexusclient.Client, t *task) (string, error) {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(t)
+	if err != nil {
+		return "", err
+	}
+	body, resp, err := client.Post(tasksAPIEndpoint, ioReader)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("could not create task \"%s\": HTTP: %d, %s", t.Name, resp.StatusCode, string(body))
+	}
+	var created task
+	if err := json.Unmarshal(body, &created); err != nil {
+		return "", fmt.Errorf("could not unmarshal task \"%s\": %v", t.Name, err)
+	}
+	return created.ID, nil
+}
+
+// getTask returns nil without an error when the task does not exist.
+func getTask(client *nexusclient.Client, id string) (*task, error) {
+	body, resp, err := client.Get(fmt.Sprintf("%s/%s", tasksAPIEndpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read task \"%s\": HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	var t task
+	if err := json.Unmarshal(body, &t); err != nil {
+		return nil, fmt.Errorf("could not unmarshal task \"%s\": %v", id, err)
+	}
+	return &t, nil
+}
+
+func updateTask(client *nexusclient.Client, id string, t *task) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(t)
+	if err != nil {
+		return err
+	}
+	body, resp, err := client.Put(fmt.Sprintf("%s/%s", tasksAPIEndpoint, id), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update task \"%s\": HTTP %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func deleteTask(client *nexusclient.Client, id string) error {
+	body, resp, err := client.Delete(fmt.Sprintf("%s/%s", tasksAPIEndpoint, id))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("could not delete task \"%s\": HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package blobstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+const (
+	blobstoreAPIEndpoint          = nexusclient.BasePath + "v1/blobstores"
+	repositorySettingsAPIEndpoint = nexusclient.BasePath + "v1/repositorySettings"
+)
+
+// getGenericBlobstore returns the usage information of a blob store, which
+// nexus only exposes through the generic blob store list.
+func getGenericBlobstore(client *nexusclient.Client, name string) (generic blobstore.Generic, err error) {
+	genericBlobstores, err := client.BlobStore.List()
+	if err != nil {
+		return
+	}
+	for _, item := range genericBlobstores {
+		if item.Name == name {
+			generic = item
+		}
+	}
+	return
+}
+
+// blobstoreExists reports whether a blob store of any type is named name.
+// The type specific getters fail on missing blob stores, so resources use
+// it to notice blob stores deleted outside of terraform.
+func blobstoreExists(client *nexusclient.Client, name string) (bool, error) {
+	genericBlobstores, err := client.BlobStore.List()
+	if err != nil {
+		return false, err
+	}
+	for _, item := range genericBlobstores {
+		if item.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getRepositoriesUsingBlobstore returns the repositories whose storage.blob_store_name
+// is the given blob store. The repository list endpoint does not contain the
+// storage settings, so the settings of all repositories are read at once.
+func getRepositoriesUsingBlobstore(client *nexusclient.Client, name string) ([]repository.LegacyRepository, error) {
+	body, resp, err := client.Get(repositorySettingsAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list repository settings: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	var repositories []repository.LegacyRepository
+	if err := json.Unmarshal(body, &repositories); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository settings: %v", err)
+	}
+
+	var used []repository.LegacyRepository
+	for _, repo := range repositories {
+		if repo.Storage != nil && repo.Storage.BlobStoreName == name {
+			used = append(used, repo)
+		}
+	}
+	return used, nil
+}
+
+func formatRepositoryList(repositories []repository.LegacyRepository) string {
+	lines := make([]string, 0, len(repositories))
+	for _, repo := range repositories {
+		lines = append(lines, fmt.Sprintf("  - %s (%s %s)", repo.Name, repo.Format, repo.Type))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// planBlobstoreDestroy warns at plan time that a blob store which is still
+// used by repositories can only be destroyed after them. The repositories may
+// be destroyed by the same apply, so this is not an error.
+func planBlobstoreDestroy(ctx context.Context, client *nexusclient.Client, state tfsdk.State) (diags diag.Diagnostics) {
+	if client == nil || state.Raw.IsNull() {
+		return
+	}
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() {
+		return
+	}
+
+	repositories, err := getRepositoriesUsingBlobstore(client, id.ValueString())
+	if err != nil {
+		diags.AddWarning("Get repositories of blobstore failed", err.Error())
+		return
+	}
+	if len(repositories) > 0 {
+		diags.AddWarning(
+			"Blobstore is in use",
+			fmt.Sprintf("Blobstore %q is used by the following repositories:\n%s\n\n"+
+				"The blobstore can only be destroyed once these repositories have been destroyed or moved to another blobstore.",
+				id.ValueString(), formatRepositoryList(repositories)),
+		)
+	}
+	return
+}
+
+// deleteBlobstore deletes a blob store after checking that no repository uses
+// it. Nexus refuses to delete a blob store that any repository references, so
+// nothing is changed when one does.
+func deleteBlobstore(client *nexusclient.Client, name string) (diags diag.Diagnostics) {
+	repositories, err := getRepositoriesUsingBlobstore(client, name)
+	if err != nil {
+		diags.AddError("Get repositories of blobstore failed", err.Error())
+		return
+	}
+	if len(repositories) > 0 {
+		diags.AddError(
+			"Blobstore is in use",
+			fmt.Sprintf("Blobstore %q cannot be deleted, it is used by the following repositories:\n%s\n\n"+
+				"Destroy these repositories or move them to another blobstore first.",
+				name, formatRepositoryList(repositories)),
+		)
+		return
+	}
+
+	if err := client.BlobStore.Delete(name); err != nil {
+		diags.AddError("Error Deleting blobstore", "Could not delete, unexpected error: "+err.Error())
+	}
+	return
+}
+
+// getBlobstoreQuotaStatus reads the soft quota status of a blob store.
+func getBlobstoreQuotaStatus(client *nexusclient.Client, name string) (*blobstore.QuotaStatus, error) {
+	body, resp, err := client.Get(fmt.Sprintf("%s/%s/quota-status", blobstoreAPIEndpoint, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read quota status of blobstore \"%s\": HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	var status blobstore.QuotaStatus
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("could not unmarshal quota status of blobstore \"%s\": %v", name, err)
+	}
+	return &status, nil
+}
@@ -0,0 +1,75 @@
+package blobstore_test
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// request sends an api request to the mock server outside of terraform.
+func request(t *testing.T, server *mocknexus.Server, method string, path string, body string) {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+"/service/rest/"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(server.Username, server.Password)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		t.Fatalf("%s %s: HTTP %d", method, path, resp.StatusCode)
+	}
+}
+
+func TestDeleteBlobstoreInUse(t *testing.T) {
+	server := acctest.NewServer(t)
+	config := server.ProviderConfig() + `
+resource "nexus_blobstore_file" "test" {
+  name = "in-use"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				PreConfig: func() {
+					request(t, server, http.MethodPost, "v1/repositories/raw/hosted",
+						`{"name":"unmanaged","online":true,"storage":{"blobStoreName":"in-use","strictContentTypeValidation":true,"writePolicy":"ALLOW"}}`)
+				},
+				Config:      server.ProviderConfig(),
+				ExpectError: regexp.MustCompile(`(?s)Blobstore "in-use" cannot be deleted.*unmanaged`),
+			},
+			{
+				// The refused destroy keeps the blobstore and must not have
+				// changed the repository.
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckBlobstore(server, "in-use", "name", "in-use"),
+					acctest.CheckRepository(server, "unmanaged", "online", "true"),
+					acctest.CheckRepository(server, "unmanaged", "storage.blobStoreName", "in-use"),
+					acctest.CheckRepository(server, "unmanaged", "storage.writePolicy", "ALLOW"),
+				),
+			},
+			{
+				PreConfig: func() {
+					request(t, server, http.MethodDelete, "v1/repositories/unmanaged", "")
+				},
+				Config: server.ProviderConfig(),
+			},
+		},
+	})
+}
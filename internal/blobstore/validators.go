@@ -0,0 +1,55 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var windowsAbsolutePathRegexp = regexp.MustCompile(`^[a-zA-Z]:[\\/]`)
+
+type blobstorePathValidator struct{}
+
+// blobstorePath validates a file blob store path. Absolute paths are used as
+// they are, relative paths are resolved against the sonatype-work directory
+// and must stay inside of it.
+func blobstorePath() validator.String {
+	return blobstorePathValidator{}
+}
+
+func (v blobstorePathValidator) Description(ctx context.Context) string {
+	return "value must be an absolute path, or a relative path which does not leave the sonatype-work directory"
+}
+
+func (v blobstorePathValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v blobstorePathValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	if strings.TrimSpace(value) == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Blobstore Path", "The path must not be empty.")
+		return
+	}
+	if strings.TrimSpace(value) != value {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Blobstore Path",
+			fmt.Sprintf("The path %q must not start or end with whitespace.", value))
+		return
+	}
+	if strings.HasPrefix(value, "/") || windowsAbsolutePathRegexp.MatchString(value) {
+		return
+	}
+	for _, segment := range strings.FieldsFunc(value, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if segment == ".." {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Blobstore Path",
+				fmt.Sprintf("The relative path %q must not contain '..', use an absolute path to store blobs outside of the sonatype-work directory.", value))
+			return
+		}
+	}
+}
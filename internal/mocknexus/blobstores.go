@@ -0,0 +1,207 @@
+package mocknexus
+
+import (
+	"net/http"
+	"sort"
+)
+
+// blobstoreTypes maps the api path of a blob store kind to its type name.
+var blobstoreTypes = map[string]string{
+	"file":  "File",
+	"s3":    "S3",
+	"azure": "Azure",
+	"group": "Group",
+}
+
+// DefaultAvailableSpace is reported as available space of every blob store.
+const DefaultAvailableSpace = 10 * 1000 * 1000 * 1000
+
+// secretPlaceholder replaces secrets in the blob store configurations nexus returns.
+const secretPlaceholder = "#~NXRM~PLACEHOLDER~PASSWORD~#"
+
+// blobstoreSecrets are the paths of the secrets in blob store configurations.
+var blobstoreSecrets = [][]string{
+	{"bucketConfiguration", "bucketSecurity", "secretAccessKey"},
+	{"bucketConfiguration", "bucketSecurity", "sessionToken"},
+	{"bucketConfiguration", "authentication", "accountKey"},
+}
+
+// maskSecrets replaces the secrets of a blob store configuration like nexus does.
+func maskSecrets(config object) object {
+	for _, secret := range blobstoreSecrets {
+		parent := config
+		for _, key := range secret[:len(secret)-1] {
+			parent, _ = parent[key].(object)
+		}
+		if value, ok := parent[secret[len(secret)-1]].(string); ok && value != "" {
+			parent[secret[len(secret)-1]] = secretPlaceholder
+		}
+	}
+	return config
+}
+
+type blobstoreEntry struct {
+	kind      string
+	config    object
+	blobCount int64
+	totalSize int64
+}
+
+// violatesQuota reports whether the usage of the blob store exceeds its soft
+// quota.
+func (e *blobstoreEntry) violatesQuota() bool {
+	quota, ok := e.config["softQuota"].(object)
+	if !ok {
+		return false
+	}
+	limit, _ := quota["limit"].(float64)
+	switch quota["type"] {
+	case "spaceUsedQuota":
+		return float64(e.totalSize) > limit
+	case "spaceRemainingQuota":
+		return DefaultAvailableSpace < limit
+	}
+	return false
+}
+
+// SetBlobstoreUsage sets the blob count and size nexus reports for a blob store.
+func (s *Server) SetBlobstoreUsage(name string, blobCount int64, totalSizeInBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.blobstores[name]; ok {
+		entry.blobCount = blobCount
+		entry.totalSize = totalSizeInBytes
+	}
+}
+
+// Blobstore returns a copy of the configuration of a blob store as it was sent
+// to the server.
+func (s *Server) Blobstore(name string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.blobstores[name]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(entry.config), true
+}
+
+func (s *Server) registerBlobstores(mux *http.ServeMux) {
+	endpoint := basePath + "v1/blobstores"
+
+	mux.HandleFunc("GET "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		names := make([]string, 0, len(s.blobstores))
+		for name := range s.blobstores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		list := make([]object, 0, len(names))
+		for _, name := range names {
+			entry := s.blobstores[name]
+			generic := object{
+				"name":                  name,
+				"type":                  blobstoreTypes[entry.kind],
+				"unavailable":           false,
+				"blobCount":             entry.blobCount,
+				"totalSizeInBytes":      entry.totalSize,
+				"availableSpaceInBytes": DefaultAvailableSpace,
+			}
+			if quota, ok := entry.config["softQuota"]; ok && quota != nil {
+				generic["softQuota"] = quota
+			}
+			list = append(list, generic)
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+
+	mux.HandleFunc("POST "+endpoint+"/{kind}", func(w http.ResponseWriter, r *http.Request) {
+		kind := r.PathValue("kind")
+		if _, ok := blobstoreTypes[kind]; !ok {
+			notFound(w, "blobstore type", kind)
+			return
+		}
+		var config object
+		if !readJSON(w, r, &config) {
+			return
+		}
+		name := stringField(config, "name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if name == "" {
+			http.Error(w, "name is required", http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.blobstores[name]; ok {
+			http.Error(w, "blobstore "+name+" already exists", http.StatusBadRequest)
+			return
+		}
+		s.blobstores[name] = &blobstoreEntry{kind: kind, config: config}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// Both the details of a blob store kind and the quota status have two path segments.
+	mux.HandleFunc("GET "+endpoint+"/{first}/{second}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.PathValue("second") == "quota-status" {
+			name := r.PathValue("first")
+			entry, ok := s.blobstores[name]
+			if !ok {
+				notFound(w, "blobstore", name)
+				return
+			}
+			status := object{"blobStoreName": name, "isViolation": false, "message": "Blob store " + name + " is not violating its quota"}
+			if entry.violatesQuota() {
+				status["isViolation"] = true
+				status["message"] = "Blob store " + name + " is violating its quota"
+			}
+			writeJSON(w, http.StatusOK, status)
+			return
+		}
+		kind, name := r.PathValue("first"), r.PathValue("second")
+		entry, ok := s.blobstores[name]
+		if !ok || entry.kind != kind {
+			notFound(w, "blobstore", name)
+			return
+		}
+		writeJSON(w, http.StatusOK, maskSecrets(copyObject(entry.config)))
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{kind}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		kind, name := r.PathValue("kind"), r.PathValue("name")
+		var config object
+		if !readJSON(w, r, &config) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		entry, ok := s.blobstores[name]
+		if !ok || entry.kind != kind {
+			notFound(w, "blobstore", name)
+			return
+		}
+		config["name"] = name
+		entry.config = config
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE "+endpoint+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.blobstores[name]; !ok {
+			notFound(w, "blobstore", name)
+			return
+		}
+		for _, repo := range s.repositories {
+			if storage, ok := repo["storage"].(object); ok && storage["blobStoreName"] == name {
+				http.Error(w, "blobstore "+name+" is in use by repository "+stringField(repo, "name"), http.StatusBadRequest)
+				return
+			}
+		}
+		delete(s.blobstores, name)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,258 @@
+package mocknexus
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// repositoryFormats maps the api path of a repository format to the format
+// name nexus reports.
+var repositoryFormats = map[string]string{
+	"apt":       "apt",
+	"bower":     "bower",
+	"cocoapods": "cocoapods",
+	"conan":     "conan",
+	"conda":     "conda",
+	"docker":    "docker",
+	"gitlfs":    "gitlfs",
+	"go":        "go",
+	"helm":      "helm",
+	"maven":     "maven2",
+	"npm":       "npm",
+	"nuget":     "nuget",
+	"p2":        "p2",
+	"pypi":      "pypi",
+	"r":         "r",
+	"raw":       "raw",
+	"rubygems":  "rubygems",
+	"yum":       "yum",
+}
+
+var repositoryTypes = map[string]bool{
+	"hosted": true,
+	"proxy":  true,
+	"group":  true,
+}
+
+func (s *Server) repositoryURL(name string) string {
+	return s.URL + "/repository/" + name
+}
+
+// sortedRepositories returns copies of all repositories ordered by name.
+func (s *Server) sortedRepositories() []object {
+	names := make([]string, 0, len(s.repositories))
+	for name := range s.repositories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	list := make([]object, 0, len(names))
+	for _, name := range names {
+		list = append(list, copyObject(s.repositories[name]))
+	}
+	return list
+}
+
+// Repository returns a copy of a repository as it was sent to the server, with
+// its format, type and url.
+func (s *Server) Repository(name string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	repo, ok := s.repositories[name]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(repo), true
+}
+
+func (s *Server) registerRepositories(mux *http.ServeMux) {
+	endpoint := basePath + "v1/repositories"
+
+	mux.HandleFunc("GET "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		list := []object{}
+		for _, repo := range s.sortedRepositories() {
+			list = append(list, object{
+				"name":       repo["name"],
+				"format":     repo["format"],
+				"type":       repo["type"],
+				"url":        repo["url"],
+				"attributes": object{},
+			})
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+
+	mux.HandleFunc("GET "+basePath+"v1/repositorySettings", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		writeJSON(w, http.StatusOK, s.sortedRepositories())
+	})
+
+	mux.HandleFunc("GET "+endpoint+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		repo, ok := s.repositories[name]
+		if !ok {
+			notFound(w, "repository", name)
+			return
+		}
+		writeJSON(w, http.StatusOK, copyObject(repo))
+	})
+
+	mux.HandleFunc("POST "+endpoint+"/{format}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		format, repoType, ok := repositoryKind(w, r)
+		if !ok {
+			return
+		}
+		var repo object
+		if !readJSON(w, r, &repo) {
+			return
+		}
+		name := stringField(repo, "name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if name == "" {
+			http.Error(w, "name is required", http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.repositories[name]; ok {
+			http.Error(w, "repository "+name+" already exists", http.StatusBadRequest)
+			return
+		}
+		if !s.validRepositoryStorage(w, repo) || !s.validDockerPorts(w, name, repo) {
+			return
+		}
+		repo["format"] = format
+		repo["type"] = repoType
+		repo["url"] = s.repositoryURL(name)
+		s.repositories[name] = repo
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	mux.HandleFunc("GET "+endpoint+"/{format}/{type}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		format, repoType, ok := repositoryKind(w, r)
+		if !ok {
+			return
+		}
+		name := r.PathValue("name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		repo, ok := s.repositories[name]
+		if !ok || repo["format"] != format || repo["type"] != repoType {
+			notFound(w, "repository", name)
+			return
+		}
+		writeJSON(w, http.StatusOK, copyObject(repo))
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{format}/{type}/{name}", func(w http.ResponseWriter, r *http.Request) {
+		format, repoType, ok := repositoryKind(w, r)
+		if !ok {
+			return
+		}
+		name := r.PathValue("name")
+		var repo object
+		if !readJSON(w, r, &repo) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		existing, ok := s.repositories[name]
+		if !ok || existing["format"] != format || existing["type"] != repoType {
+			notFound(w, "repository", name)
+			return
+		}
+		if !s.validRepositoryStorage(w, repo) || !s.validDockerPorts(w, name, repo) {
+			return
+		}
+		repo["name"] = name
+		repo["format"] = format
+		repo["type"] = repoType
+		repo["url"] = s.repositoryURL(name)
+		s.repositories[name] = repo
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE "+endpoint+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := r.PathValue("name")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.repositories[name]; !ok {
+			notFound(w, "repository", name)
+			return
+		}
+		delete(s.repositories, name)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// repositoryKind returns the format and type of the request path, or answers
+// with 404 for unknown ones.
+func repositoryKind(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	format, ok := repositoryFormats[r.PathValue("format")]
+	if !ok {
+		notFound(w, "repository format", r.PathValue("format"))
+		return "", "", false
+	}
+	repoType := r.PathValue("type")
+	if !repositoryTypes[repoType] {
+		notFound(w, "repository type", repoType)
+		return "", "", false
+	}
+	return format, repoType, true
+}
+
+// validRepositoryStorage answers with 400 when the repository uses a blob
+// store that does not exist.
+func (s *Server) validRepositoryStorage(w http.ResponseWriter, repo object) bool {
+	storage, ok := repo["storage"].(object)
+	if !ok {
+		return true
+	}
+	name := stringField(storage, "blobStoreName")
+	if name == "" {
+		return true
+	}
+	if _, ok := s.blobstores[name]; !ok {
+		http.Error(w, "blobstore "+name+" does not exist", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// validDockerPorts answers with 400 when a connector port of the docker
+// repository name is used by another repository, like nexus does.
+func (s *Server) validDockerPorts(w http.ResponseWriter, name string, repo object) bool {
+	for _, port := range dockerPorts(repo) {
+		for other, existing := range s.repositories {
+			if other == name {
+				continue
+			}
+			for _, used := range dockerPorts(existing) {
+				if used == port {
+					http.Error(w, fmt.Sprintf("port %v is already used by repository %s", port, other), http.StatusBadRequest)
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// dockerPorts returns the connector ports of a docker repository.
+func dockerPorts(repo object) []float64 {
+	docker, ok := repo["docker"].(object)
+	if !ok {
+		return nil
+	}
+	var ports []float64
+	for _, key := range []string{"httpPort", "httpsPort"} {
+		if port, ok := docker[key].(float64); ok {
+			ports = append(ports, port)
+		}
+	}
+	return ports
+}
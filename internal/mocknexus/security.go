@@ -0,0 +1,247 @@
+package mocknexus
+
+import (
+	"io"
+	"net/http"
+	"sort"
+)
+
+// AvailableRealms are the realms the server offers.
+var AvailableRealms = []string{
+	"NexusAuthenticatingRealm",
+	"DockerToken",
+	"NpmToken",
+	"NuGetApiKey",
+	"rutauth-realm",
+	"LdapRealm",
+	"User-Token-Realm",
+}
+
+func sortedObjects(objects map[string]object) []object {
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	list := make([]object, 0, len(ids))
+	for _, id := range ids {
+		list = append(list, copyObject(objects[id]))
+	}
+	return list
+}
+
+func (s *Server) registerSecurity(mux *http.ServeMux) {
+	s.registerUsers(mux)
+	s.registerRoles(mux)
+
+	realms := basePath + "v1/security/realms"
+	mux.HandleFunc("GET "+realms+"/available", func(w http.ResponseWriter, r *http.Request) {
+		list := make([]object, 0, len(AvailableRealms))
+		for _, id := range AvailableRealms {
+			list = append(list, object{"id": id, "name": id})
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+	mux.HandleFunc("GET "+realms+"/active", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		writeJSON(w, http.StatusOK, append([]string{}, s.activeRealms...))
+	})
+	mux.HandleFunc("PUT "+realms+"/active", func(w http.ResponseWriter, r *http.Request) {
+		var active []string
+		if !readJSON(w, r, &active) {
+			return
+		}
+		for _, id := range active {
+			known := false
+			for _, available := range AvailableRealms {
+				known = known || id == available
+			}
+			if !known {
+				http.Error(w, "unknown realm "+id, http.StatusBadRequest)
+				return
+			}
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.activeRealms = active
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	anonymous := basePath + "v1/security/anonymous"
+	mux.HandleFunc("GET "+anonymous, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		writeJSON(w, http.StatusOK, copyObject(s.anonymous))
+	})
+	mux.HandleFunc("PUT "+anonymous, func(w http.ResponseWriter, r *http.Request) {
+		var settings object
+		if !readJSON(w, r, &settings) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.anonymous = settings
+		writeJSON(w, http.StatusOK, copyObject(settings))
+	})
+}
+
+func (s *Server) registerUsers(mux *http.ServeMux) {
+	endpoint := basePath + "v1/security/users"
+
+	mux.HandleFunc("GET "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		userID := r.URL.Query().Get("userId")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		list := []object{}
+		for _, user := range sortedObjects(s.users) {
+			if userID == "" || user["userId"] == userID {
+				list = append(list, user)
+			}
+		}
+		writeJSON(w, http.StatusOK, list)
+	})
+
+	mux.HandleFunc("POST "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		var user object
+		if !readJSON(w, r, &user) {
+			return
+		}
+		id := stringField(user, "userId")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if id == "" {
+			http.Error(w, "userId is required", http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.users[id]; ok {
+			http.Error(w, "user "+id+" already exists", http.StatusBadRequest)
+			return
+		}
+		// Nexus never returns passwords.
+		delete(user, "password")
+		user["source"] = "default"
+		s.users[id] = user
+		writeJSON(w, http.StatusOK, copyObject(user))
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		var user object
+		if !readJSON(w, r, &user) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.users[id]; !ok {
+			notFound(w, "user", id)
+			return
+		}
+		delete(user, "password")
+		user["userId"] = id
+		user["source"] = "default"
+		s.users[id] = user
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{id}/change-password", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		password, err := io.ReadAll(r.Body)
+		if err != nil || len(password) == 0 {
+			http.Error(w, "password is required", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.users[id]; !ok {
+			notFound(w, "user", id)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.users[id]; !ok {
+			notFound(w, "user", id)
+			return
+		}
+		delete(s.users, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) registerRoles(mux *http.ServeMux) {
+	endpoint := basePath + "v1/security/roles"
+
+	mux.HandleFunc("GET "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		writeJSON(w, http.StatusOK, sortedObjects(s.roles))
+	})
+
+	mux.HandleFunc("GET "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		role, ok := s.roles[id]
+		if !ok {
+			notFound(w, "role", id)
+			return
+		}
+		writeJSON(w, http.StatusOK, copyObject(role))
+	})
+
+	mux.HandleFunc("POST "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		var role object
+		if !readJSON(w, r, &role) {
+			return
+		}
+		id := stringField(role, "id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if id == "" {
+			http.Error(w, "id is required", http.StatusBadRequest)
+			return
+		}
+		if _, ok := s.roles[id]; ok {
+			http.Error(w, "role "+id+" already exists", http.StatusBadRequest)
+			return
+		}
+		role["source"] = "default"
+		s.roles[id] = role
+		writeJSON(w, http.StatusOK, copyObject(role))
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		var role object
+		if !readJSON(w, r, &role) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.roles[id]; !ok {
+			notFound(w, "role", id)
+			return
+		}
+		role["id"] = id
+		role["source"] = "default"
+		s.roles[id] = role
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.roles[id]; !ok {
+			notFound(w, "role", id)
+			return
+		}
+		delete(s.roles, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
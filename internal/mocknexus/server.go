@@ -0,0 +1,200 @@
+// Package mocknexus serves an in-memory nexus REST API over httptest, so that
+// resources and data sources can be exercised without a running nexus.
+//
+// The server covers blob stores, repositories, security users, roles, realms
+// and the anonymous configuration, scheduled tasks and the status endpoints.
+// It keeps the JSON documents it receives and returns them as they were sent,
+// it does not validate them the way nexus does.
+package mocknexus
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+const (
+	DefaultUsername = "admin"
+	DefaultPassword = "admin123"
+	DefaultEdition  = "PRO"
+	DefaultVersion  = "3.70.1-02"
+
+	basePath = "/service/rest/"
+)
+
+type object = map[string]interface{}
+
+// Server is an in-memory nexus. Change the exported fields before the first
+// request.
+type Server struct {
+	*httptest.Server
+
+	// Username and Password are the accepted basic auth credentials.
+	Username string
+	Password string
+	// BearerToken is accepted as bearer token when it is not empty.
+	BearerToken string
+	// Edition and Version are reported in the Server header, the api
+	// documentation and the license api.
+	Edition string
+	Version string
+	NodeID  string
+	// HideServerHeader omits the Server header, like a proxy which removes it.
+	HideServerHeader bool
+	// LegacyTasks makes the task details contain the task summary only, like
+	// older nexus versions.
+	LegacyTasks bool
+
+	mu           sync.Mutex
+	blobstores   map[string]*blobstoreEntry
+	repositories map[string]object
+	users        map[string]object
+	roles        map[string]object
+	activeRealms []string
+	anonymous    object
+	tasks        map[string]object
+	nextTaskID   int
+}
+
+// NewServer starts an empty nexus with the default credentials. Close it when
+// it is not needed anymore.
+func NewServer() *Server {
+	s := newServer()
+	s.Start()
+	return s
+}
+
+// NewTLSServer starts an empty nexus which is reached with https. The
+// connections use tlsConfig, such as one requiring client certificates, with
+// a self signed server certificate added.
+func NewTLSServer(tlsConfig *tls.Config) *Server {
+	s := newServer()
+	s.TLS = tlsConfig
+	s.StartTLS()
+	return s
+}
+
+func newServer() *Server {
+	s := &Server{
+		Username:     DefaultUsername,
+		Password:     DefaultPassword,
+		Edition:      DefaultEdition,
+		Version:      DefaultVersion,
+		NodeID:       "00000000-0000-0000-0000-000000000001",
+		blobstores:   map[string]*blobstoreEntry{},
+		repositories: map[string]object{},
+		users:        map[string]object{},
+		roles:        map[string]object{},
+		activeRealms: []string{"NexusAuthenticatingRealm"},
+		anonymous:    object{"enabled": false, "userId": "anonymous", "realmName": "NexusAuthorizingRealm"},
+		tasks:        map[string]object{},
+	}
+
+	mux := http.NewServeMux()
+	s.registerStatus(mux)
+	s.registerBlobstores(mux)
+	s.registerRepositories(mux)
+	s.registerSecurity(mux)
+	s.registerTasks(mux)
+	s.Server = httptest.NewUnstartedServer(s.authenticate(mux))
+	return s
+}
+
+// authenticate rejects invalid credentials like nexus does. Requests without
+// credentials are only allowed to read the status, or to read anything while
+// anonymous access is enabled.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.HideServerHeader {
+			w.Header().Set("Server", fmt.Sprintf("Nexus/%s (%s)", s.Version, s.Edition))
+		}
+
+		authorization := r.Header.Get("Authorization")
+		username, password, basic := r.BasicAuth()
+		switch {
+		case basic && username == s.Username && password == s.Password:
+		case s.BearerToken != "" && authorization == "Bearer "+s.BearerToken:
+		case authorization == "" && r.Method == http.MethodGet && (r.URL.Path == basePath+"v1/status" || s.anonymousEnabled()):
+		default:
+			http.Error(w, "", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) anonymousEnabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	enabled, _ := s.anonymous["enabled"].(bool)
+	return enabled
+}
+
+func (s *Server) registerStatus(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+basePath+"v1/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET "+basePath+"v1/status/writable", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET "+basePath+"v1/system/node", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, object{"nodeId": s.NodeID})
+	})
+	mux.HandleFunc("GET "+basePath+"v1/system/license", func(w http.ResponseWriter, r *http.Request) {
+		if s.Edition != "PRO" {
+			http.Error(w, "", http.StatusPaymentRequired)
+			return
+		}
+		writeJSON(w, http.StatusOK, object{"licenseType": "PRODUCTION", "features": []string{"NexusProfessional"}})
+	})
+	mux.HandleFunc("GET "+basePath+"swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, object{"swagger": "2.0", "info": object{"version": s.Version, "title": "Nexus Repository Manager REST API"}})
+	})
+}
+
+// readJSON decodes the request body into v, or answers with 400.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, fmt.Sprintf("invalid JSON: %v", err), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func notFound(w http.ResponseWriter, kind string, name string) {
+	http.Error(w, fmt.Sprintf("%s %q not found", kind, name), http.StatusNotFound)
+}
+
+// copyObject returns a deep copy of o, so that callers can not change the
+// stored documents.
+func copyObject(o object) object {
+	data, _ := json.Marshal(o)
+	var c object
+	_ = json.Unmarshal(data, &c)
+	return c
+}
+
+func stringField(o object, key string) string {
+	s, _ := o[key].(string)
+	return s
+}
+
+// ProviderConfig returns a provider block that connects to the server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "nexus" {
+  url      = %q
+  username = %q
+  password = %q
+}
+`, s.URL, s.Username, s.Password)
+}
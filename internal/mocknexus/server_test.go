@@ -0,0 +1,99 @@
+package mocknexus
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// do sends a request with the default credentials and decodes a JSON response into v.
+func do(t *testing.T, s *Server, method string, path string, body string, v interface{}) int {
+	t.Helper()
+	req, err := http.NewRequest(method, s.URL+basePath+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(s.Username, s.Password)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("%s %s: %v: %s", method, path, err, data)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestAuthentication(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	for _, tc := range []struct {
+		name     string
+		path     string
+		username string
+		password string
+		bearer   string
+		want     int
+	}{
+		{name: "status without credentials", path: "v1/status", want: http.StatusOK},
+		{name: "status with invalid credentials", path: "v1/status", username: "admin", password: "wrong", want: http.StatusUnauthorized},
+		{name: "api without credentials", path: "v1/blobstores", want: http.StatusUnauthorized},
+		{name: "api with credentials", path: "v1/blobstores", username: DefaultUsername, password: DefaultPassword, want: http.StatusOK},
+		{name: "api with unknown bearer token", path: "v1/blobstores", bearer: "token", want: http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, s.URL+basePath+tc.path, nil)
+			if tc.username != "" {
+				req.SetBasicAuth(tc.username, tc.password)
+			}
+			if tc.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.bearer)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.want {
+				t.Errorf("got HTTP %d, want %d", resp.StatusCode, tc.want)
+			}
+			if got := resp.Header.Get("Server"); got != "Nexus/"+DefaultVersion+" (PRO)" {
+				t.Errorf("got Server header %q", got)
+			}
+		})
+	}
+}
+
+func TestBlobstoreInUse(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	if code := do(t, s, http.MethodPost, "v1/blobstores/file", `{"name":"store","path":"store"}`, nil); code != http.StatusNoContent {
+		t.Fatalf("create blobstore: HTTP %d", code)
+	}
+	repo := `{"name":"raw","online":false,"storage":{"blobStoreName":"store","strictContentTypeValidation":true,"writePolicy":"ALLOW"}}`
+	if code := do(t, s, http.MethodPost, "v1/repositories/raw/hosted", repo, nil); code != http.StatusCreated {
+		t.Fatalf("create repository: HTTP %d", code)
+	}
+	// Nexus refuses to delete a blob store that is used by an offline repository, too.
+	if code := do(t, s, http.MethodDelete, "v1/blobstores/store", "", nil); code != http.StatusBadRequest {
+		t.Fatalf("delete used blobstore: got HTTP %d, want 400", code)
+	}
+	if code := do(t, s, http.MethodDelete, "v1/repositories/raw", "", nil); code != http.StatusNoContent {
+		t.Fatalf("delete repository: HTTP %d", code)
+	}
+	if code := do(t, s, http.MethodDelete, "v1/blobstores/store", "", nil); code != http.StatusNoContent {
+		t.Fatalf("delete unused blobstore: HTTP %d", code)
+	}
+}
@@ -0,0 +1,127 @@
+package mocknexus
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// taskXO returns the task summary nexus answers with when a task is created or
+// listed. It does not contain the frequency and the properties of the task.
+func taskXO(t object) object {
+	xo := object{
+		"id":            t["id"],
+		"name":          t["name"],
+		"type":          t["type"],
+		"message":       nil,
+		"currentState":  "WAITING",
+		"lastRunResult": nil,
+		"nextRun":       nil,
+		"lastRun":       nil,
+	}
+	frequency, _ := t["frequency"].(object)
+	if enabled, ok := t["enabled"].(bool); ok && !enabled {
+		return xo
+	}
+	if startDate, ok := frequency["startDate"].(float64); ok && startDate > 0 && stringField(frequency, "schedule") != "manual" {
+		xo["nextRun"] = time.Unix(int64(startDate), 0).UTC().Format(time.RFC3339)
+	}
+	return xo
+}
+
+// Task returns a copy of a task as it was sent to the server, with its id.
+func (s *Server) Task(id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(t), true
+}
+
+func (s *Server) registerTasks(mux *http.ServeMux) {
+	endpoint := basePath + "v1/tasks"
+
+	mux.HandleFunc("GET "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		taskType := r.URL.Query().Get("type")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		items := []object{}
+		for _, t := range sortedObjects(s.tasks) {
+			if taskType == "" || t["type"] == taskType {
+				items = append(items, taskXO(t))
+			}
+		}
+		writeJSON(w, http.StatusOK, object{"items": items, "continuationToken": nil})
+	})
+
+	mux.HandleFunc("POST "+endpoint, func(w http.ResponseWriter, r *http.Request) {
+		var t object
+		if !readJSON(w, r, &t) {
+			return
+		}
+		if stringField(t, "type") == "" || stringField(t, "name") == "" {
+			http.Error(w, "type and name are required", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.nextTaskID++
+		id := fmt.Sprintf("%08d-0000-0000-0000-000000000000", s.nextTaskID)
+		t["id"] = id
+		s.tasks[id] = t
+		writeJSON(w, http.StatusCreated, taskXO(t))
+	})
+
+	mux.HandleFunc("GET "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		t, ok := s.tasks[id]
+		if !ok {
+			notFound(w, "task", id)
+			return
+		}
+		if s.LegacyTasks {
+			writeJSON(w, http.StatusOK, taskXO(t))
+			return
+		}
+		// Recent nexus versions return the frequency and the properties
+		// together with the summary.
+		details := copyObject(t)
+		for key, value := range taskXO(t) {
+			details[key] = value
+		}
+		writeJSON(w, http.StatusOK, details)
+	})
+
+	mux.HandleFunc("PUT "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		var t object
+		if !readJSON(w, r, &t) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.tasks[id]; !ok {
+			notFound(w, "task", id)
+			return
+		}
+		t["id"] = id
+		s.tasks[id] = t
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("DELETE "+endpoint+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.tasks[id]; !ok {
+			notFound(w, "task", id)
+			return
+		}
+		delete(s.tasks, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
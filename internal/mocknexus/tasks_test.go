@@ -0,0 +1,58 @@
+package mocknexus
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestTaskSummary(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	body := `{"type":"blobstore.compact","name":"compact","enabled":true,` +
+		`"frequency":{"schedule":"daily","startDate":1704067200},"properties":{"blobstoreName":"default"}}`
+	var created map[string]interface{}
+	if code := do(t, s, http.MethodPost, "v1/tasks", body, &created); code != http.StatusCreated {
+		t.Fatalf("create task: HTTP %d", code)
+	}
+	id, _ := created["id"].(string)
+	want := map[string]interface{}{
+		"id":           id,
+		"name":         "compact",
+		"type":         "blobstore.compact",
+		"currentState": "WAITING",
+		"nextRun":      "2024-01-01T00:00:00Z",
+	}
+	for key, value := range want {
+		if created[key] != value {
+			t.Errorf("created task: %s is %v, want %v", key, created[key], value)
+		}
+	}
+	for _, key := range []string{"frequency", "properties"} {
+		if _, ok := created[key]; ok {
+			t.Errorf("created task: unexpected %s", key)
+		}
+	}
+
+	var list struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	if code := do(t, s, http.MethodGet, "v1/tasks?type=blobstore.compact", "", &list); code != http.StatusOK {
+		t.Fatalf("list tasks: HTTP %d", code)
+	}
+	if len(list.Items) != 1 || list.Items[0]["id"] != id || list.Items[0]["currentState"] != "WAITING" {
+		t.Errorf("list tasks: got %v", list.Items)
+	}
+
+	var read map[string]interface{}
+	if code := do(t, s, http.MethodGet, "v1/tasks/"+id, "", &read); code != http.StatusOK {
+		t.Fatalf("read task: HTTP %d", code)
+	}
+	if read["currentState"] != "WAITING" || read["frequency"] == nil || read["properties"] == nil {
+		t.Errorf("read task: got %v", read)
+	}
+
+	if code := do(t, s, http.MethodPost, "v1/tasks", `{"type":"blobstore.compact"}`, nil); code != http.StatusBadRequest {
+		t.Errorf("create task without name: got HTTP %d, want 400", code)
+	}
+}
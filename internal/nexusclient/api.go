@@ -0,0 +1,51 @@
+package nexusclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// BasePath is the path of the nexus rest api, relative to the server URL.
+const BasePath = "service/rest/"
+
+const contentTypeJSON = "application/json"
+
+// Get, Post, Put and Delete send a request to the endpoint, relative to the
+// server URL, and return the response with its body read.
+func (c *Client) Get(endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	return c.execute(http.MethodGet, endpoint, payload)
+}
+
+func (c *Client) Post(endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	return c.execute(http.MethodPost, endpoint, payload)
+}
+
+func (c *Client) Put(endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	return c.execute(http.MethodPut, endpoint, payload)
+}
+
+func (c *Client) Delete(endpoint string) ([]byte, *http.Response, error) {
+	return c.execute(http.MethodDelete, endpoint, nil)
+}
+
+func (c *Client) execute(method string, endpoint string, payload io.Reader) ([]byte, *http.Response, error) {
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/%s", c.config.URL, endpoint), payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The header transport replaces the basic auth of anonymous and bearer
+	// token clients.
+	req.SetBasicAuth(c.config.Username, c.config.Password)
+	req.Header.Set("Content-Type", contentTypeJSON)
+	req.Header.Set("Accept", contentTypeJSON)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	return body, resp, err
+}
@@ -0,0 +1,120 @@
+package nexusclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/blobstore"
+)
+
+const blobstoresAPIEndpoint = BasePath + "v1/blobstores"
+
+// BlobStoreService lists and deletes blob stores of any type, the typed
+// services manage the settings of one type.
+type BlobStoreService struct {
+	client *Client
+
+	Azure *BlobStoreTypeService[blobstore.Azure]
+	File  *BlobStoreTypeService[blobstore.File]
+	Group *BlobStoreTypeService[blobstore.Group]
+	S3    *BlobStoreTypeService[blobstore.S3]
+}
+
+func newBlobStoreService(c *Client) *BlobStoreService {
+	return &BlobStoreService{
+		client: c,
+		Azure:  &BlobStoreTypeService[blobstore.Azure]{client: c, kind: "azure"},
+		File:   &BlobStoreTypeService[blobstore.File]{client: c, kind: "file"},
+		Group:  &BlobStoreTypeService[blobstore.Group]{client: c, kind: "group"},
+		S3:     &BlobStoreTypeService[blobstore.S3]{client: c, kind: "s3"},
+	}
+}
+
+func (s *BlobStoreService) List() ([]blobstore.Generic, error) {
+	body, resp, err := s.client.Get(blobstoresAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list blobstores: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	var blobstores []blobstore.Generic
+	if err := json.Unmarshal(body, &blobstores); err != nil {
+		return nil, fmt.Errorf("could not unmarshal list of generic blobstores: %v", err)
+	}
+	return blobstores, nil
+}
+
+func (s *BlobStoreService) Delete(name string) error {
+	body, resp, err := s.client.Delete(fmt.Sprintf("%s/%s", blobstoresAPIEndpoint, name))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not delete blobstore \"%s\": HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// BlobStoreTypeService manages the blob stores of one type, T is the
+// go-nexus-client schema of the type.
+type BlobStoreTypeService[T any] struct {
+	client *Client
+	kind   string
+}
+
+func (s *BlobStoreTypeService[T]) Create(bs *T) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Post(fmt.Sprintf("%s/%s", blobstoresAPIEndpoint, s.kind), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create %s blobstore: HTTP: %d, %s", s.kind, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// Get reads the blob store named name. Nexus leaves the name out of the
+// response, it is set from the argument.
+func (s *BlobStoreTypeService[T]) Get(name string) (*T, error) {
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s/%s", blobstoresAPIEndpoint, s.kind, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read %s blobstore \"%s\": HTTP: %d, %s", s.kind, name, resp.StatusCode, string(body))
+	}
+	var bs T
+	if err := json.Unmarshal(body, &bs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal blobstore \"%s\": %v", name, err)
+	}
+	nameJSON, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(nameJSON, &bs); err != nil {
+		return nil, fmt.Errorf("could not unmarshal blobstore \"%s\": %v", name, err)
+	}
+	return &bs, nil
+}
+
+func (s *BlobStoreTypeService[T]) Update(name string, bs *T) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(bs)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s/%s", blobstoresAPIEndpoint, s.kind, name), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update blobstore \"%s\": HTTP %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package nexusclient
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// listCacheTTL bounds how long a cached list response is used. The cache
+// lives as long as the provider process, which is a single plan or apply.
+const listCacheTTL = 1 * time.Minute
+
+// cachedEndpoints are the list endpoints many resources read during a plan.
+var cachedEndpoints = map[string]bool{
+	"/" + BasePath + "v1/blobstores":         true,
+	"/" + BasePath + "v1/repositories":       true,
+	"/" + BasePath + "v1/repositorySettings": true,
+}
+
+type cachedResponse struct {
+	done    chan struct{}
+	expires time.Time
+	resp    *http.Response
+	body    []byte
+	err     error
+}
+
+// cacheTransport caches successful responses of the list endpoints and
+// coalesces concurrent requests of the same endpoint into one. Every write
+// request clears the cache, so resources read their own changes.
+type cacheTransport struct {
+	next http.RoundTripper
+
+	mu         sync.Mutex
+	generation uint64
+	entries    map[string]*cachedResponse
+}
+
+func newCacheTransport(next http.RoundTripper) *cacheTransport {
+	return &cacheTransport{next: next, entries: map[string]*cachedResponse{}}
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		t.invalidate()
+		resp, err := t.next.RoundTrip(req)
+		t.invalidate()
+		return resp, err
+	}
+	if req.Method != http.MethodGet || !cachedEndpoints[req.URL.Path] {
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.URL.String() + "\n" + req.Header.Get("Authorization")
+	t.mu.Lock()
+	entry, ok := t.entries[key]
+	if ok && time.Now().Before(entry.expires) {
+		t.mu.Unlock()
+		return entry.wait(req)
+	}
+	entry = &cachedResponse{done: make(chan struct{}), expires: time.Now().Add(listCacheTTL)}
+	t.entries[key] = entry
+	generation := t.generation
+	t.mu.Unlock()
+
+	entry.resp, entry.err = t.next.RoundTrip(req)
+	if entry.err == nil {
+		entry.body, entry.err = io.ReadAll(entry.resp.Body)
+		entry.resp.Body.Close()
+	}
+	close(entry.done)
+
+	t.mu.Lock()
+	if entry.err != nil || entry.resp.StatusCode != http.StatusOK || generation != t.generation {
+		// Only successful responses which are not older than the last write are kept.
+		if t.entries[key] == entry {
+			delete(t.entries, key)
+		}
+	}
+	t.mu.Unlock()
+	return entry.response(req)
+}
+
+func (t *cacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.generation++
+	t.entries = map[string]*cachedResponse{}
+}
+
+func (e *cachedResponse) wait(req *http.Request) (*http.Response, error) {
+	select {
+	case <-e.done:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	return e.response(req)
+}
+
+// response returns a copy of the cached response with its own body.
+func (e *cachedResponse) response(req *http.Request) (*http.Response, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	resp := *e.resp
+	resp.Header = e.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(e.body))
+	resp.Request = req
+	return &resp, nil
+}
@@ -0,0 +1,168 @@
+package nexusclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCacheTransport(t *testing.T) {
+	const (
+		list  = "/service/rest/v1/repositories"
+		other = "/service/rest/v1/status"
+	)
+
+	type request struct {
+		method string
+		path   string
+		status int
+		// authorization is sent as Authorization header.
+		authorization string
+		// sent is whether the request reaches nexus.
+		sent bool
+	}
+	for _, tc := range []struct {
+		name     string
+		requests []request
+	}{
+		{name: "list is cached", requests: []request{
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodGet, path: list},
+		}},
+		{name: "put clears the cache", requests: []request{
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodPut, path: list + "/maven/hosted/releases", sent: true},
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodGet, path: list},
+		}},
+		{name: "post clears the cache", requests: []request{
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodPost, path: list + "/maven/hosted", sent: true},
+			{method: http.MethodGet, path: list, sent: true},
+		}},
+		{name: "delete clears the cache", requests: []request{
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodDelete, path: list + "/releases", sent: true},
+			{method: http.MethodGet, path: list, sent: true},
+		}},
+		{name: "failed write clears the cache", requests: []request{
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodPut, path: list + "/maven/hosted/releases", status: http.StatusBadRequest, sent: true},
+			{method: http.MethodGet, path: list, sent: true},
+		}},
+		{name: "other endpoints are not cached", requests: []request{
+			{method: http.MethodGet, path: other, sent: true},
+			{method: http.MethodGet, path: other, sent: true},
+		}},
+		{name: "errors are not cached", requests: []request{
+			{method: http.MethodGet, path: list, status: http.StatusServiceUnavailable, sent: true},
+			{method: http.MethodGet, path: list, sent: true},
+			{method: http.MethodGet, path: list},
+		}},
+		{name: "credentials are cached separately", requests: []request{
+			{method: http.MethodGet, path: list, authorization: "Bearer a", sent: true},
+			{method: http.MethodGet, path: list, authorization: "Bearer b", sent: true},
+			{method: http.MethodGet, path: list, authorization: "Bearer a"},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var sent int
+			var status int
+			transport := newCacheTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				sent++
+				return &http.Response{
+					StatusCode: status,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(fmt.Sprintf("response %d", sent))),
+				}, nil
+			}))
+
+			// cached are the bodies nexus returned, by endpoint and credentials.
+			cached := map[string]string{}
+			for i, r := range tc.requests {
+				status = r.status
+				if status == 0 {
+					status = http.StatusOK
+				}
+				before := sent
+				req, _ := http.NewRequest(r.method, "http://nexus"+r.path, nil)
+				if r.authorization != "" {
+					req.Header.Set("Authorization", r.authorization)
+				}
+				resp, err := transport.RoundTrip(req)
+				if err != nil {
+					t.Fatalf("request %d: %v", i+1, err)
+				}
+				body, _ := io.ReadAll(resp.Body)
+				resp.Body.Close()
+
+				if got := sent > before; got != r.sent {
+					t.Errorf("request %d %s %s: got sent %v, want %v", i+1, r.method, r.path, got, r.sent)
+				}
+				key := r.path + "\n" + r.authorization
+				want := cached[key]
+				if r.sent {
+					want = fmt.Sprintf("response %d", sent)
+					cached[key] = want
+				}
+				if string(body) != want {
+					t.Errorf("request %d %s %s: got body %q, want %q", i+1, r.method, r.path, body, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheTransportSharesConcurrentRequests(t *testing.T) {
+	const requests = 10
+	var mu sync.Mutex
+	var sent int
+	started, release := make(chan struct{}), make(chan struct{})
+	transport := newCacheTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		sent++
+		if sent == 1 {
+			close(started)
+		}
+		mu.Unlock()
+		<-release
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("[]"))}, nil
+	}))
+
+	var wg sync.WaitGroup
+	bodies := make([]string, requests)
+	errs := make([]error, requests)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "http://nexus/service/rest/v1/blobstores", nil)
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			bodies[i] = string(body)
+		}(i)
+	}
+	// The first request reaches nexus, the others arrive while it is pending.
+	<-started
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if sent != 1 {
+		t.Errorf("got %d requests to nexus, want 1", sent)
+	}
+	for i := range bodies {
+		if errs[i] != nil || bodies[i] != "[]" {
+			t.Errorf("request %d: got body %q and error %v", i+1, bodies[i], errs[i])
+		}
+	}
+}
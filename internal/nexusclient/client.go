@@ -0,0 +1,121 @@
+// Package nexusclient builds the nexus client shared by all resources and
+// data sources, with the http behaviour configured on the provider.
+//
+// The services of go-nexus-client send their requests through an http.Client
+// which client.NewClient builds with a fixed timeout and its own
+// http.Transport. Neither can be replaced or wrapped, and the services only
+// accept that concrete client. So the provider keeps the go-nexus-client
+// schema types, and its own services send the requests through an
+// http.Client whose transports add the timeout, retries, concurrency limit,
+// tls, proxy and header settings of the provider.
+package nexusclient
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultMaxRetries   = 3
+	DefaultRetryBackoff = 1 * time.Second
+
+	DefaultMaxConcurrentRequests = 10
+)
+
+// DefaultRetryableStatusCodes are returned by nexus while it starts, and by
+// reverse proxies while nexus is overloaded or restarting.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Config is the configuration of the nexus client.
+type Config struct {
+	URL      string
+	Username string
+	Password string
+	Insecure bool
+	// Anonymous sends requests without credentials.
+	Anonymous bool
+	// BearerToken replaces the basic authentication of username and password.
+	BearerToken string
+
+	// CACertPEM are PEM encoded CA certificates trusted in addition to the system roots.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM are the PEM encoded key pair for mutual TLS.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+
+	// ProxyURL is the proxy of all requests, NoProxy lists the hosts which are
+	// reached without it, in the format of the NO_PROXY environment variable.
+	ProxyURL string
+	NoProxy  string
+	// Headers are added to every request.
+	Headers map[string]string
+
+	// Timeout limits a single http request, including reading the response body.
+	Timeout time.Duration
+	// MaxRetries is the number of retries after the first attempt of a request.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, it doubles with every retry.
+	RetryBackoff time.Duration
+	// RetryableStatusCodes are the response codes a request is retried for.
+	RetryableStatusCodes []int
+	// MaxConcurrentRequests limits the requests in flight, 0 means no limit.
+	MaxConcurrentRequests int
+}
+
+// Client is the provider data shared with all resources and data sources.
+type Client struct {
+	config     Config
+	httpClient *http.Client
+
+	BlobStore  *BlobStoreService
+	Repository *RepositoryService
+
+	// Server is detected by Ping, it is empty until then.
+	Server ServerInfo
+	// Ports are the connector ports of the docker repositories planned so far.
+	Ports PlannedPorts
+}
+
+// NewClient returns a nexus client which honors the tls, proxy, header,
+// timeout, retry and concurrency settings of config. Responses of list
+// endpoints are cached until the next write request.
+func NewClient(config Config) (*Client, error) {
+	tlsConfig, err := tlsConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := proxyFunc(config)
+	if err != nil {
+		return nil, err
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = proxy
+
+	// The timeout is applied by the retry transport to every attempt of a request.
+	httpClient := &http.Client{
+		Transport: &headerTransport{
+			anonymous:   config.Anonymous,
+			bearerToken: config.BearerToken,
+			headers:     config.Headers,
+			next: newCacheTransport(&retryTransport{
+				next:                 newLimitTransport(transport, config.MaxConcurrentRequests),
+				timeout:              config.Timeout,
+				maxRetries:           config.MaxRetries,
+				backoff:              config.RetryBackoff,
+				retryableStatusCodes: config.RetryableStatusCodes,
+			}),
+		},
+	}
+
+	c := &Client{config: config, httpClient: httpClient}
+	c.BlobStore = newBlobStoreService(c)
+	c.Repository = newRepositoryService(c)
+	return c, nil
+}
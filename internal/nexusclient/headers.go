@@ -0,0 +1,30 @@
+package nexusclient
+
+import "net/http"
+
+// headerTransport adds the configured headers to every request. Anonymous
+// requests are sent without the basic auth header the client always sets,
+// a bearer token replaces it. Configured headers take precedence over both.
+type headerTransport struct {
+	next        http.RoundTripper
+	anonymous   bool
+	bearerToken string
+	headers     map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.headers) == 0 && !t.anonymous && t.bearerToken == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	switch {
+	case t.anonymous:
+		req.Header.Del("Authorization")
+	case t.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	}
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,52 @@
+package nexusclient
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// limitTransport limits the number of requests in flight at the same time.
+// A request stays in flight until its response body is closed, so a slow
+// reader cannot exceed the limit. Retries wait for their backoff outside of
+// the limit.
+type limitTransport struct {
+	next http.RoundTripper
+	sem  chan struct{}
+}
+
+func newLimitTransport(next http.RoundTripper, limit int) http.RoundTripper {
+	if limit <= 0 {
+		return next
+	}
+	return &limitTransport{next: next, sem: make(chan struct{}, limit)}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	release := func() { <-t.sem }
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseBody frees the slot of a request once its body is closed.
+type releaseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,124 @@
+package nexusclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLimitTransport(t *testing.T) {
+	const limit, requests = 3, 12
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	transport := newLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+	}), limit)
+
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+			resp, err := transport.RoundTrip(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight != limit {
+		t.Errorf("got %d requests in flight, want %d", maxInFlight, limit)
+	}
+}
+
+func TestLimitTransportCanceledWhileWaiting(t *testing.T) {
+	held, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	transport := newLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		close(held)
+		<-release
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: http.NoBody}, nil
+	}), 1)
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+		_, _ = transport.RoundTrip(req)
+	}()
+	<-held
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestLimitTransportHoldsUntilBodyClosed(t *testing.T) {
+	transport := newLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}), 1)
+
+	req, _ := http.NewRequest(http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := transport.RoundTrip(req.WithContext(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v while the first body is open, want %v", err, context.DeadlineExceeded)
+	}
+
+	resp.Body.Close()
+	resp.Body.Close()
+	for i := 0; i < 2; i++ {
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+}
+
+func TestLimitTransportReleasesOnError(t *testing.T) {
+	failure := errors.New("connection refused")
+	transport := newLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, failure
+	}), 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://nexus/service/rest/v1/status", nil)
+	for i := 0; i < 3; i++ {
+		if _, err := transport.RoundTrip(req); !errors.Is(err, failure) {
+			t.Fatalf("got error %v, want %v", err, failure)
+		}
+	}
+}
+
+func TestLimitTransportWithoutLimit(t *testing.T) {
+	next := roundTripperFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+	if _, ok := newLimitTransport(next, 0).(*limitTransport); ok {
+		t.Error("a limit of 0 must not limit the requests")
+	}
+}
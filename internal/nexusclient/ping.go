@@ -0,0 +1,35 @@
+package nexusclient
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const statusAPIEndpoint = BasePath + "v1/status"
+
+// PingError is returned by Ping when nexus answered with an unexpected status.
+type PingError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *PingError) Error() string {
+	return fmt.Sprintf("HTTP: %d, %s", e.StatusCode, e.Body)
+}
+
+// Ping checks that nexus is reachable and, unless the client is anonymous,
+// accepts the credentials. Nexus rejects invalid credentials even for the
+// status endpoint, which anonymous users may read. The edition and version of
+// the server are detected from the Server header of the response, or from the
+// api when the header does not reveal them.
+func (c *Client) Ping() error {
+	body, resp, err := c.Get(statusAPIEndpoint, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &PingError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	c.Server = c.detectServer(resp.Header.Get("Server"))
+	return nil
+}
@@ -0,0 +1,47 @@
+package nexusclient
+
+import (
+	"sort"
+	"sync"
+)
+
+// PlannedPorts records the connector ports of the docker repositories planned
+// by a provider instance, keyed by repository name. Terraform configures a
+// provider instance for every command, so it only sees the repositories of
+// one plan or apply.
+type PlannedPorts struct {
+	mu    sync.Mutex
+	ports map[string][]int64
+}
+
+// Claim records ports for the repository name, replacing the ports it claimed
+// before, so planning a repository again is no conflict. It returns a port
+// which another repository has claimed, with that repository, and records
+// nothing then. Whichever of two repositories claims a port second gets the
+// conflict.
+func (p *PlannedPorts) Claim(name string, ports []int64) (int64, string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ports == nil {
+		p.ports = map[string][]int64{}
+	}
+	owners := make([]string, 0, len(p.ports))
+	for owner := range p.ports {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	for _, port := range ports {
+		for _, owner := range owners {
+			if owner == name {
+				continue
+			}
+			for _, claimed := range p.ports[owner] {
+				if claimed == port {
+					return port, owner
+				}
+			}
+		}
+	}
+	p.ports[name] = ports
+	return 0, ""
+}
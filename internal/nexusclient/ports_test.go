@@ -0,0 +1,24 @@
+package nexusclient
+
+import "testing"
+
+func TestPlannedPorts(t *testing.T) {
+	var ports PlannedPorts
+	if port, owner := ports.Claim("first", []int64{8090, 8443}); owner != "" {
+		t.Fatalf("got port %d of %q claimed", port, owner)
+	}
+	// Planning a repository again replaces its ports.
+	if port, owner := ports.Claim("first", []int64{8091}); owner != "" {
+		t.Fatalf("got port %d of %q claimed by the same repository", port, owner)
+	}
+	if port, owner := ports.Claim("second", []int64{8090}); owner != "" {
+		t.Fatalf("got port %d of %q claimed, the first repository released it", port, owner)
+	}
+	if port, owner := ports.Claim("third", []int64{8092, 8091}); port != 8091 || owner != "first" {
+		t.Errorf("got port %d of %q, want 8091 of first", port, owner)
+	}
+	// A conflicting claim records nothing.
+	if port, owner := ports.Claim("fourth", []int64{8092}); owner != "" {
+		t.Errorf("got port %d of %q claimed by a refused claim", port, owner)
+	}
+}
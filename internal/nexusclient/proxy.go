@@ -0,0 +1,36 @@
+package nexusclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxyFunc returns the proxy selection of the transport. Without a proxy
+// url the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are
+// used, noProxy replaces NO_PROXY in either case.
+func proxyFunc(config Config) (func(*http.Request) (*url.URL, error), error) {
+	if config.ProxyURL == "" && config.NoProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyConfig := httpproxy.FromEnvironment()
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy url %q, expected an url such as http://proxy.example.com:3128", config.ProxyURL)
+		}
+		proxyConfig.HTTPProxy = config.ProxyURL
+		proxyConfig.HTTPSProxy = config.ProxyURL
+	}
+	if config.NoProxy != "" {
+		proxyConfig.NoProxy = config.NoProxy
+	}
+
+	proxy := proxyConfig.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
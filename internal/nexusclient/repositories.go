@@ -0,0 +1,162 @@
+package nexusclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+const repositoriesAPIEndpoint = BasePath + "v1/repositories"
+
+// RepositoryService lists and deletes repositories of any format, the format
+// services manage the settings of one format and type.
+type RepositoryService struct {
+	client *Client
+
+	Apt      *AptRepositoryService
+	Maven    *RepositoryFormatService[repository.MavenHostedRepository, repository.MavenProxyRepository, repository.MavenGroupRepository]
+	Npm      *RepositoryFormatService[repository.NpmHostedRepository, repository.NpmProxyRepository, repository.NpmGroupRepository]
+	Nuget    *RepositoryFormatService[repository.NugetHostedRepository, repository.NugetProxyRepository, repository.NugetGroupRepository]
+	Pypi     *RepositoryFormatService[repository.PypiHostedRepository, repository.PypiProxyRepository, repository.PypiGroupRepository]
+	Raw      *RepositoryFormatService[repository.RawHostedRepository, repository.RawProxyRepository, repository.RawGroupRepository]
+	RubyGems *RepositoryFormatService[repository.RubyGemsHostedRepository, repository.RubyGemsProxyRepository, repository.RubyGemsGroupRepository]
+}
+
+// AptRepositoryService manages apt repositories, nexus has no apt groups.
+type AptRepositoryService struct {
+	Hosted *RepositoryTypeService[repository.AptHostedRepository]
+	Proxy  *RepositoryTypeService[repository.AptProxyRepository]
+}
+
+// RepositoryFormatService manages the hosted, proxy and group repositories of
+// one format. The format is the path of its api, "maven" for maven2.
+type RepositoryFormatService[H, P, G any] struct {
+	Hosted *RepositoryTypeService[H]
+	Proxy  *RepositoryTypeService[P]
+	Group  *RepositoryTypeService[G]
+}
+
+func newRepositoryFormatService[H, P, G any](c *Client, format string) *RepositoryFormatService[H, P, G] {
+	return &RepositoryFormatService[H, P, G]{
+		Hosted: newRepositoryTypeService[H](c, format, repository.RepositoryTypeHosted),
+		Proxy:  newRepositoryTypeService[P](c, format, repository.RepositoryTypeProxy),
+		Group:  newRepositoryTypeService[G](c, format, repository.RepositoryTypeGroup),
+	}
+}
+
+func newRepositoryService(c *Client) *RepositoryService {
+	return &RepositoryService{
+		client: c,
+		Apt: &AptRepositoryService{
+			Hosted: newRepositoryTypeService[repository.AptHostedRepository](c, "apt", repository.RepositoryTypeHosted),
+			Proxy:  newRepositoryTypeService[repository.AptProxyRepository](c, "apt", repository.RepositoryTypeProxy),
+		},
+		Maven:    newRepositoryFormatService[repository.MavenHostedRepository, repository.MavenProxyRepository, repository.MavenGroupRepository](c, "maven"),
+		Npm:      newRepositoryFormatService[repository.NpmHostedRepository, repository.NpmProxyRepository, repository.NpmGroupRepository](c, "npm"),
+		Nuget:    newRepositoryFormatService[repository.NugetHostedRepository, repository.NugetProxyRepository, repository.NugetGroupRepository](c, "nuget"),
+		Pypi:     newRepositoryFormatService[repository.PypiHostedRepository, repository.PypiProxyRepository, repository.PypiGroupRepository](c, "pypi"),
+		Raw:      newRepositoryFormatService[repository.RawHostedRepository, repository.RawProxyRepository, repository.RawGroupRepository](c, "raw"),
+		RubyGems: newRepositoryFormatService[repository.RubyGemsHostedRepository, repository.RubyGemsProxyRepository, repository.RubyGemsGroupRepository](c, "rubygems"),
+	}
+}
+
+func (s *RepositoryService) List() ([]repository.RepositoryInfo, error) {
+	body, resp, err := s.client.Get(repositoriesAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list repository infos: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	var repositoryInfos []repository.RepositoryInfo
+	if err := json.Unmarshal(body, &repositoryInfos); err != nil {
+		return nil, fmt.Errorf("could not unmarshal list of repository infos: %v", err)
+	}
+	return repositoryInfos, nil
+}
+
+// Delete deletes the repository named id, of any format and type.
+func (s *RepositoryService) Delete(id string) error {
+	return deleteRepository(s.client, id)
+}
+
+func deleteRepository(c *Client, id string) error {
+	body, resp, err := c.Delete(fmt.Sprintf("%s/%s", repositoriesAPIEndpoint, id))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not delete repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// RepositoryTypeService manages the repositories of one format and type, T
+// is the go-nexus-client schema of the repository.
+type RepositoryTypeService[T any] struct {
+	client   *Client
+	endpoint string
+}
+
+func newRepositoryTypeService[T any](c *Client, format string, repoType string) *RepositoryTypeService[T] {
+	return &RepositoryTypeService[T]{
+		client:   c,
+		endpoint: fmt.Sprintf("%s/%s/%s", repositoriesAPIEndpoint, format, repoType),
+	}
+}
+
+func (s *RepositoryTypeService[T]) Create(repo T) error {
+	data, err := json.Marshal(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Post(s.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var named struct {
+			Name string `json:"name"`
+		}
+		_ = json.Unmarshal(data, &named)
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", named.Name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryTypeService[T]) Get(id string) (*T, error) {
+	body, resp, err := s.client.Get(fmt.Sprintf("%s/%s", s.endpoint, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not read repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	var repo T
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return &repo, nil
+}
+
+func (s *RepositoryTypeService[T]) Update(id string, repo T) error {
+	data, err := json.Marshal(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := s.client.Put(fmt.Sprintf("%s/%s", s.endpoint, id), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", id, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (s *RepositoryTypeService[T]) Delete(id string) error {
+	return deleteRepository(s.client, id)
+}
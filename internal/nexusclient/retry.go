@@ -0,0 +1,140 @@
+package nexusclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxRetryWait caps the exponential backoff and the Retry-After header.
+const maxRetryWait = 2 * time.Minute
+
+// retryTransport retries idempotent requests which failed with a network
+// error or one of the retryable status codes, waiting backoff, 2*backoff,
+// 4*backoff, ... between the attempts. A Retry-After header of the response
+// takes precedence. Other requests, such as POST, are only retried when the
+// connection could not be established, because nexus may have processed them
+// already otherwise. Every attempt is limited by timeout on its own.
+type retryTransport struct {
+	next                 http.RoundTripper
+	timeout              time.Duration
+	maxRetries           int
+	backoff              time.Duration
+	retryableStatusCodes []int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripOnce(req)
+		if attempt >= t.maxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// The body has been consumed and can not be sent again.
+			return resp, err
+		}
+
+		wait := t.wait(attempt, resp)
+		if resp != nil {
+			// Drain the body so that the connection can be reused.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *retryTransport) roundTripOnce(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// Certificate problems, such as a rejected client certificate, are not transient.
+		var verificationErr *tls.CertificateVerificationError
+		var opErr *net.OpError
+		if errors.As(err, &verificationErr) || (errors.As(err, &opErr) && opErr.Op == "remote error") {
+			return false
+		}
+		if !idempotent(req.Method) {
+			// The request has not been sent when the connection failed.
+			return errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "proxyconnect")
+		}
+		return !errors.Is(err, context.Canceled)
+	}
+	if !idempotent(req.Method) {
+		return false
+	}
+	for _, code := range t.retryableStatusCodes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// idempotent reports whether sending a request with method twice has the same
+// effect as sending it once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func (t *retryTransport) wait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, maxRetryWait)
+		}
+	}
+	wait := t.backoff
+	for i := 0; i < attempt && wait < maxRetryWait; i++ {
+		wait *= 2
+	}
+	return min(wait, maxRetryWait)
+}
+
+// cancelBody releases the timeout of an attempt once its body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
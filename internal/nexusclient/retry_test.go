@@ -0,0 +1,99 @@
+package nexusclient
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransport(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		// results are returned by the attempts, a status code or an error.
+		results      []interface{}
+		wantAttempts int
+		wantStatus   int
+	}{
+		{name: "get is retried on status codes", method: http.MethodGet, results: []interface{}{503, 502, 200}, wantAttempts: 3, wantStatus: 200},
+		{name: "get is retried on network errors", method: http.MethodGet, results: []interface{}{readErr, 200}, wantAttempts: 2, wantStatus: 200},
+		{name: "put is retried", method: http.MethodPut, results: []interface{}{readErr, 503, 204}, wantAttempts: 3, wantStatus: 204},
+		{name: "delete is retried", method: http.MethodDelete, results: []interface{}{503, 204}, wantAttempts: 2, wantStatus: 204},
+		{name: "retries are limited", method: http.MethodGet, results: []interface{}{503, 503, 503, 503, 200}, wantAttempts: 4, wantStatus: 503},
+		{name: "other status codes are not retried", method: http.MethodGet, results: []interface{}{500, 200}, wantAttempts: 1, wantStatus: 500},
+		{name: "post is not retried on status codes", method: http.MethodPost, results: []interface{}{503, 201}, wantAttempts: 1, wantStatus: 503},
+		{name: "post is not retried after it was sent", method: http.MethodPost, results: []interface{}{readErr, 201}, wantAttempts: 1},
+		{name: "post is retried when nexus could not be connected", method: http.MethodPost, results: []interface{}{dialErr, 201}, wantAttempts: 2, wantStatus: 201},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var bodies []string
+			transport := &retryTransport{
+				maxRetries:           3,
+				backoff:              time.Millisecond,
+				retryableStatusCodes: DefaultRetryableStatusCodes,
+				next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					body, _ := io.ReadAll(req.Body)
+					bodies = append(bodies, string(body))
+					switch result := tc.results[len(bodies)-1].(type) {
+					case error:
+						return nil, result
+					default:
+						return &http.Response{StatusCode: result.(int), Header: http.Header{}, Body: http.NoBody}, nil
+					}
+				}),
+			}
+			req, _ := http.NewRequest(tc.method, "http://nexus/service/rest/v1/tasks", strings.NewReader("body"))
+			resp, err := transport.RoundTrip(req)
+
+			if len(bodies) != tc.wantAttempts {
+				t.Errorf("got %d attempts, want %d", len(bodies), tc.wantAttempts)
+			}
+			for i, body := range bodies {
+				if body != "body" {
+					t.Errorf("attempt %d sent body %q", i+1, body)
+				}
+			}
+			switch {
+			case tc.wantStatus == 0 && err == nil:
+				t.Errorf("got HTTP %d, want an error", resp.StatusCode)
+			case tc.wantStatus != 0 && err != nil:
+				t.Errorf("got error %v, want HTTP %d", err, tc.wantStatus)
+			case tc.wantStatus != 0 && resp.StatusCode != tc.wantStatus:
+				t.Errorf("got HTTP %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRetryTransportWait(t *testing.T) {
+	transport := &retryTransport{backoff: time.Second}
+	retryAfter := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+
+	for _, tc := range []struct {
+		attempt int
+		resp    *http.Response
+		want    time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 2, want: 4 * time.Second},
+		{attempt: 20, want: maxRetryWait},
+		{attempt: 2, resp: retryAfter, want: 7 * time.Second},
+	} {
+		if got := transport.wait(tc.attempt, tc.resp); got != tc.want {
+			t.Errorf("wait(%d) = %v, want %v", tc.attempt, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package nexusclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	EditionPro = "PRO"
+	EditionOSS = "OSS"
+
+	nodeAPIEndpoint    = BasePath + "v1/system/node"
+	licenseAPIEndpoint = BasePath + "v1/system/license"
+	swaggerEndpoint    = BasePath + "swagger.json"
+)
+
+// serverHeaderRegexp matches the Server header of nexus, such as "Nexus/3.70.1-02 (OSS)".
+var serverHeaderRegexp = regexp.MustCompile(`Nexus/([0-9][0-9.\-]*)(?:\s+\(([A-Za-z]+)\))?`)
+
+// ServerInfo describes the nexus server the provider is connected to.
+// Edition and Version are empty when they could neither be read from the
+// Server header nor from the api.
+type ServerInfo struct {
+	Edition string
+	Version string
+}
+
+func parseServerHeader(header string) ServerInfo {
+	m := serverHeaderRegexp.FindStringSubmatch(header)
+	if m == nil {
+		return ServerInfo{}
+	}
+	return ServerInfo{
+		Edition: strings.ToUpper(m[2]),
+		Version: m[1],
+	}
+}
+
+// detectServer reads the edition and version from the Server header and asks
+// the api for whatever the header does not reveal, for example behind a proxy
+// which rewrites it.
+func (c *Client) detectServer(header string) ServerInfo {
+	info := parseServerHeader(header)
+	if info.Version == "" {
+		info.Version = c.apiVersion()
+	}
+	if info.Edition == "" {
+		info.Edition = c.licensedEdition()
+	}
+	return info
+}
+
+// apiVersion returns the nexus version the api documentation is generated
+// for, or an empty string when it cannot be read.
+func (c *Client) apiVersion() string {
+	body, resp, err := c.Get(swaggerEndpoint, nil)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return ""
+	}
+	var swagger struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+	}
+	if err := json.Unmarshal(body, &swagger); err != nil || !serverHeaderRegexp.MatchString("Nexus/"+swagger.Info.Version) {
+		return ""
+	}
+	return swagger.Info.Version
+}
+
+// licensedEdition returns the edition from the license api, which answers
+// with 402 on Nexus OSS, or an empty string when the license cannot be read.
+func (c *Client) licensedEdition() string {
+	_, resp, err := c.Get(licenseAPIEndpoint, nil)
+	if err != nil {
+		return ""
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return EditionPro
+	case http.StatusPaymentRequired:
+		return EditionOSS
+	}
+	return ""
+}
+
+// Detected reports whether both the edition and the version of the server
+// are known.
+func (s ServerInfo) Detected() bool {
+	return s.Edition != "" && s.Version != ""
+}
+
+// AtLeast reports whether the server version is at least min, such as "3.30.0".
+// An unknown version is never recent enough.
+func (s ServerInfo) AtLeast(min string) bool {
+	if s.Version == "" {
+		return false
+	}
+	version, minimum := versionNumbers(s.Version), versionNumbers(min)
+	for i, m := range minimum {
+		v := 0
+		if i < len(version) {
+			v = version[i]
+		}
+		if v != m {
+			return v > m
+		}
+	}
+	return true
+}
+
+// versionNumbers splits a version such as "3.70.1-02" into its numbers.
+func versionNumbers(version string) []int {
+	fields := strings.FieldsFunc(version, func(r rune) bool { return r == '.' || r == '-' })
+	numbers := make([]int, 0, len(fields))
+	for _, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			break
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
+
+// RequirePro returns an error diagnostic when feature is not available on the
+// server, because it is not a Nexus Pro or older than minVersion, or when the
+// edition or version could not be detected.
+func (s ServerInfo) RequirePro(feature string, minVersion string) (diags diag.Diagnostics) {
+	summary := fmt.Sprintf("%s requires Nexus Pro >= %s", feature, minVersion)
+	notAvailable := fmt.Sprintf("%s is only available in Nexus Pro %s and later, the provider is connected to %s.", feature, minVersion, s)
+	switch {
+	case s.Edition != "" && s.Edition != EditionPro:
+		diags.AddError(summary, notAvailable)
+	case !s.Detected():
+		diags.AddError(summary, fmt.Sprintf("%s is only available in Nexus Pro %s and later, but the provider could not detect %s. %s", feature, minVersion, s.unknown(), undetectedHint))
+	case !s.AtLeast(minVersion):
+		diags.AddError(summary, notAvailable)
+	}
+	return
+}
+
+// RequireVersion returns an error diagnostic when feature is not available on
+// the server because it is older than minVersion, or when the version could
+// not be detected.
+func (s ServerInfo) RequireVersion(feature string, minVersion string) (diags diag.Diagnostics) {
+	summary := fmt.Sprintf("%s requires Nexus >= %s", feature, minVersion)
+	switch {
+	case s.Version == "":
+		diags.AddError(summary, fmt.Sprintf("%s is only available in Nexus %s and later, but the provider could not detect the version of nexus. %s", feature, minVersion, undetectedHint))
+	case !s.AtLeast(minVersion):
+		diags.AddError(summary, fmt.Sprintf("%s is only available in Nexus %s and later, the provider is connected to %s.", feature, minVersion, s))
+	}
+	return
+}
+
+// undetectedHint explains how to let the provider detect the server.
+const undetectedHint = "Nexus reveals them in the Server header, which a proxy in front of nexus may remove, " +
+	"and in the api, which requires a user allowed to read the license and the api documentation."
+
+// unknown names what could not be detected.
+func (s ServerInfo) unknown() string {
+	switch {
+	case s.Edition == "" && s.Version == "":
+		return "the edition and version of nexus"
+	case s.Edition == "":
+		return "the edition of nexus"
+	}
+	return "the version of nexus"
+}
+
+func (s ServerInfo) String() string {
+	if s.Version == "" && s.Edition == "" {
+		return "an unknown Nexus version"
+	}
+	return strings.TrimSpace(fmt.Sprintf("Nexus %s %s", s.Edition, s.Version))
+}
+
+// NodeID returns the id of the nexus node the provider is connected to.
+func (c *Client) NodeID() (string, error) {
+	body, resp, err := c.Get(nodeAPIEndpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not read node id: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	var node struct {
+		NodeID string `json:"nodeId"`
+	}
+	if err := json.Unmarshal(body, &node); err != nil {
+		return "", fmt.Errorf("could not unmarshal node id: %v", err)
+	}
+	return node.NodeID, nil
+}
@@ -0,0 +1,96 @@
+package nexusclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+func TestPingDetectsServer(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		edition    string
+		hideHeader bool
+	}{
+		{name: "pro from header", edition: "PRO"},
+		{name: "oss from header", edition: "OSS"},
+		{name: "pro from api", edition: "PRO", hideHeader: true},
+		{name: "oss from api", edition: "OSS", hideHeader: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := mocknexus.NewServer()
+			defer server.Close()
+			server.Edition = tc.edition
+			server.HideServerHeader = tc.hideHeader
+
+			client, err := NewClient(Config{URL: server.URL, Username: server.Username, Password: server.Password})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := client.Ping(); err != nil {
+				t.Fatal(err)
+			}
+			want := ServerInfo{Edition: tc.edition, Version: mocknexus.DefaultVersion}
+			if client.Server != want {
+				t.Errorf("got %+v, want %+v", client.Server, want)
+			}
+		})
+	}
+}
+
+func TestPingWithoutServerInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+statusAPIEndpoint {
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{URL: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Ping(); err != nil {
+		t.Fatal(err)
+	}
+	if client.Server.Detected() {
+		t.Fatalf("got %+v, want nothing detected", client.Server)
+	}
+
+	if diags := client.Server.RequirePro("Azure blobstores", "3.30.0"); !diags.HasError() || !strings.Contains(diags[0].Detail(), "could not detect the edition and version") {
+		t.Errorf("got %v, want an error about the undetected edition and version", diags)
+	}
+	if diags := client.Server.RequireVersion("Blobstore compact tasks", "3.67.0"); !diags.HasError() || !strings.Contains(diags[0].Detail(), "could not detect the version") {
+		t.Errorf("got %v, want an error about the undetected version", diags)
+	}
+}
+
+func TestRequirePro(t *testing.T) {
+	for _, tc := range []struct {
+		server ServerInfo
+		err    string
+	}{
+		{server: ServerInfo{Edition: EditionPro, Version: "3.70.1-02"}},
+		{server: ServerInfo{Edition: EditionPro, Version: "3.29.0-01"}, err: "connected to Nexus PRO 3.29.0-01"},
+		{server: ServerInfo{Edition: EditionOSS, Version: "3.70.1-02"}, err: "connected to Nexus OSS 3.70.1-02"},
+		{server: ServerInfo{Edition: EditionOSS}, err: "connected to Nexus OSS"},
+		{server: ServerInfo{Edition: EditionPro}, err: "could not detect the version of nexus"},
+		{server: ServerInfo{Version: "3.70.1-02"}, err: "could not detect the edition of nexus"},
+	} {
+		t.Run(tc.server.String(), func(t *testing.T) {
+			diags := tc.server.RequirePro("Azure blobstores", "3.30.0")
+			if tc.err == "" {
+				if diags.HasError() {
+					t.Errorf("got %v, want no error", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags[0].Detail(), tc.err) {
+				t.Errorf("got %v, want an error containing %q", diags, tc.err)
+			}
+		})
+	}
+}
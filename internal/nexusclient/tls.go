@@ -0,0 +1,42 @@
+package nexusclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// tlsConfig returns the tls configuration of the connection to nexus. The CA
+// certificates are trusted in addition to the system roots.
+func tlsConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.Insecure,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if len(config.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, errors.New("the CA bundle does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case len(config.ClientCertPEM) > 0 && len(config.ClientKeyPEM) > 0:
+		cert, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("could not load the client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case len(config.ClientCertPEM) > 0:
+		return nil, errors.New("a client certificate requires a client key")
+	case len(config.ClientKeyPEM) > 0:
+		return nil, errors.New("a client key requires a client certificate")
+	}
+	return tlsConfig, nil
+}
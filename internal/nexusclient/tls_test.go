@@ -0,0 +1,26 @@
+package nexusclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTLSConfigErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{name: "ca bundle without certificates", config: Config{CACertPEM: []byte("not a certificate")}, err: "does not contain any PEM encoded certificate"},
+		{name: "client certificate without key", config: Config{ClientCertPEM: []byte("cert")}, err: "requires a client key"},
+		{name: "client key without certificate", config: Config{ClientKeyPEM: []byte("key")}, err: "requires a client certificate"},
+		{name: "invalid key pair", config: Config{ClientCertPEM: []byte("cert"), ClientKeyPEM: []byte("key")}, err: "could not load the client certificate"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tlsConfig(tc.config)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("got error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
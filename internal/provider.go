@@ -2,31 +2,64 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	neturl "net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
-	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/serialt/terraform-provider-nexus/internal/blobstore"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
 	"github.com/serialt/terraform-provider-nexus/internal/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/system"
 )
 
 var _ provider.Provider = &NexusProvider{}
 var _ provider.ProviderWithFunctions = &NexusProvider{}
+var _ provider.ProviderWithConfigValidators = &NexusProvider{}
 
 type NexusProvider struct {
 }
 
 type NexusProviderModel struct {
-	Insecure types.Bool   `tfsdk:"insecure"`
-	Password types.String `tfsdk:"password"`
-	URL      types.String `tfsdk:"url"`
-	Username types.String `tfsdk:"username"`
+	Insecure              types.Bool   `tfsdk:"insecure"`
+	Password              types.String `tfsdk:"password"`
+	URL                   types.String `tfsdk:"url"`
+	Username              types.String `tfsdk:"username"`
+	Anonymous             types.Bool   `tfsdk:"anonymous"`
+	UserTokenNameCode     types.String `tfsdk:"user_token_name_code"`
+	UserTokenPassCode     types.String `tfsdk:"user_token_pass_code"`
+	BearerToken           types.String `tfsdk:"bearer_token"`
+	Timeout               types.Int64  `tfsdk:"timeout"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryBackoff          types.Int64  `tfsdk:"retry_backoff"`
+	RetryableStatusCodes  types.List   `tfsdk:"retryable_status_codes"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	CACertPEM             types.String `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String `tfsdk:"ca_cert_file"`
+	ClientCert            types.String `tfsdk:"client_cert"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	NoProxy               types.String `tfsdk:"no_proxy"`
+	Headers               types.Map    `tfsdk:"headers"`
 }
 
 func (p *NexusProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -51,10 +84,153 @@ func (p *NexusProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 			"insecure": schema.BoolAttribute{
 				Optional: true,
 			},
+			"user_token_name_code": schema.StringAttribute{
+				MarkdownDescription: "Name code of a Nexus Pro user token, used instead of `username`. Requires `user_token_pass_code`. " +
+					"Can also be set with the `NEXUS_USER_TOKEN_NAME_CODE` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"user_token_pass_code": schema.StringAttribute{
+				MarkdownDescription: "Pass code of a Nexus Pro user token, used instead of `password`. Requires `user_token_name_code`. " +
+					"Can also be set with the `NEXUS_USER_TOKEN_PASS_CODE` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"bearer_token": schema.StringAttribute{
+				MarkdownDescription: "Token sent as `Authorization: Bearer` header instead of basic authentication, " +
+					"for nexus behind an identity-aware proxy. Can also be set with the `NEXUS_BEARER_TOKEN` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"anonymous": schema.BoolAttribute{
+				MarkdownDescription: "Connect without credentials, as the anonymous user of nexus. `username` and `password` must not be set then. " +
+					"Can also be set with the `NEXUS_ANONYMOUS` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to verify the nexus server certificate with, in addition to the system roots. " +
+					"Can also be set with the `NEXUS_CA_CERT_PEM` environment variable. Conflicts with `ca_cert_file`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file with PEM encoded CA certificates to verify the nexus server certificate with, in addition to the system roots. " +
+					"Can also be set with the `NEXUS_CA_CERT_FILE` environment variable. Conflicts with `ca_cert_pem`.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS, or the path to a file containing it. " +
+					"Requires `client_key`. Can also be set with the `NEXUS_CLIENT_CERT` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert`, or the path to a file containing it. " +
+					"Can also be set with the `NEXUS_CLIENT_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to reach nexus through, such as `http://proxy.example.com:3128`. " +
+					"Can also be set with the `NEXUS_PROXY_URL` environment variable. " +
+					"Defaults to the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+				Optional: true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma separated hosts, domains and CIDR ranges which are reached without the proxy, such as `localhost,.internal,10.0.0.0/8`. " +
+					"Can also be set with the `NEXUS_NO_PROXY` environment variable. Defaults to the `NO_PROXY` environment variable.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "HTTP headers added to every request to nexus, such as the tenant header or the token of an API gateway.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Timeout of a single request to nexus in seconds. "+
+					"Can also be set with the `NEXUS_TIMEOUT` environment variable. Defaults to `%d`.", int(nexusclient.DefaultTimeout.Seconds())),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How often a request which failed with a network error or a retryable status code is retried. "+
+					"Requests which create objects are only retried when nexus could not be connected. "+
+					"`0` disables retries. Can also be set with the `NEXUS_MAX_RETRIES` environment variable. Defaults to `%d`.", nexusclient.DefaultMaxRetries),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_backoff": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Seconds to wait before the first retry, the wait doubles with every further retry. "+
+					"A `Retry-After` header sent by nexus takes precedence. "+
+					"Can also be set with the `NEXUS_RETRY_BACKOFF` environment variable. Defaults to `%d`.", int(nexusclient.DefaultRetryBackoff.Seconds())),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of requests the provider sends to nexus at the same time, independent of the terraform parallelism. "+
+					"Can also be set with the `NEXUS_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to `%d`.", nexusclient.DefaultMaxConcurrentRequests),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retryable_status_codes": schema.ListAttribute{
+				MarkdownDescription: fmt.Sprintf("HTTP status codes a request is retried for. "+
+					"Can also be set with the `NEXUS_RETRYABLE_STATUS_CODES` environment variable as a comma separated list. Defaults to `%s`.",
+					formatStatusCodes(nexusclient.DefaultRetryableStatusCodes)),
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.Between(100, 599)),
+				},
+			},
 		},
 	}
 }
 
+// ConfigValidators allow at most one authentication method in the provider
+// block, see resolveCredentials for the precedence of the environment.
+func (p *NexusProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("bearer_token"),
+			path.MatchRoot("user_token_name_code"),
+			path.MatchRoot("password"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("bearer_token"),
+			path.MatchRoot("user_token_pass_code"),
+			path.MatchRoot("username"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("user_token_name_code"),
+			path.MatchRoot("username"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("user_token_pass_code"),
+			path.MatchRoot("password"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("user_token_name_code"),
+			path.MatchRoot("user_token_pass_code"),
+		),
+	}
+}
+
 func (p *NexusProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config NexusProviderModel
 
@@ -63,9 +239,17 @@ func (p *NexusProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if anyUnknown(config.URL, config.Username, config.Password, config.Anonymous,
+		config.UserTokenNameCode, config.UserTokenPassCode, config.BearerToken,
+		config.Insecure, config.CACertPEM, config.CACertFile, config.ClientCert, config.ClientKey,
+		config.ProxyURL, config.NoProxy, config.Headers, config.Timeout,
+		config.MaxRetries, config.RetryBackoff, config.RetryableStatusCodes, config.MaxConcurrentRequests) {
+		resp.Diagnostics.AddError("Unknown nexus connection settings",
+			"The provider settings must be known when the provider is configured, "+
+				"they can not depend on values which are only known after apply.")
+		return
+	}
 	url := os.Getenv("NEXUS_URL")
-	username := os.Getenv("NEXUS_USERNAME")
-	password := os.Getenv("NEXUS_PASSWORD")
 	insecureC := os.Getenv("NEXUS_INSECURE_SKIP_VERIFY")
 	insecure := false
 	if insecureC == "true" {
@@ -74,21 +258,81 @@ func (p *NexusProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.Insecure.IsNull() {
 		insecure = config.Insecure.ValueBool()
 	}
-	if !config.Username.IsNull() {
-		username = config.Username.ValueString()
-	}
-	if !config.Password.IsNull() {
-		password = config.Password.ValueString()
-	}
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
 	}
-	client := nexus3.NewClient(client.Config{
-		Insecure: insecure,
-		Password: password,
-		URL:      url,
-		Username: username,
-	})
+	resp.Diagnostics.Append(validateURL(config, url)...)
+	creds, diags := resolveCredentials(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clientConfig := nexusclient.Config{
+		Insecure:              insecure,
+		Password:              creds.password,
+		URL:                   url,
+		Username:              creds.username,
+		BearerToken:           creds.bearerToken,
+		Anonymous:             creds.method == authMethodAnonymous,
+		Timeout:               nexusclient.DefaultTimeout,
+		MaxRetries:            nexusclient.DefaultMaxRetries,
+		RetryBackoff:          nexusclient.DefaultRetryBackoff,
+		RetryableStatusCodes:  nexusclient.DefaultRetryableStatusCodes,
+		MaxConcurrentRequests: nexusclient.DefaultMaxConcurrentRequests,
+	}
+
+	resp.Diagnostics.Append(configureTLS(config, &clientConfig)...)
+	clientConfig.ProxyURL = stringFromConfigOrEnv(config.ProxyURL, "NEXUS_PROXY_URL")
+	clientConfig.NoProxy = stringFromConfigOrEnv(config.NoProxy, "NEXUS_NO_PROXY")
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &clientConfig.Headers, false)...)
+	}
+
+	if timeout, ok := int64FromConfigOrEnv(config.Timeout, "NEXUS_TIMEOUT", path.Root("timeout"), 1, resp); ok {
+		clientConfig.Timeout = time.Duration(timeout) * time.Second
+	}
+	if maxRetries, ok := int64FromConfigOrEnv(config.MaxRetries, "NEXUS_MAX_RETRIES", path.Root("max_retries"), 0, resp); ok {
+		clientConfig.MaxRetries = int(maxRetries)
+	}
+	if backoff, ok := int64FromConfigOrEnv(config.RetryBackoff, "NEXUS_RETRY_BACKOFF", path.Root("retry_backoff"), 0, resp); ok {
+		clientConfig.RetryBackoff = time.Duration(backoff) * time.Second
+	}
+	if limit, ok := int64FromConfigOrEnv(config.MaxConcurrentRequests, "NEXUS_MAX_CONCURRENT_REQUESTS", path.Root("max_concurrent_requests"), 1, resp); ok {
+		clientConfig.MaxConcurrentRequests = int(limit)
+	}
+	if !config.RetryableStatusCodes.IsNull() {
+		var codes []int64
+		resp.Diagnostics.Append(config.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		clientConfig.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			clientConfig.RetryableStatusCodes = append(clientConfig.RetryableStatusCodes, int(code))
+		}
+	} else if env, ok := os.LookupEnv("NEXUS_RETRYABLE_STATUS_CODES"); ok {
+		codes, err := parseStatusCodes(env)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("retryable_status_codes"), "Invalid NEXUS_RETRYABLE_STATUS_CODES environment variable", err.Error())
+		}
+		clientConfig.RetryableStatusCodes = codes
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := nexusclient.NewClient(clientConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create nexus client", err.Error())
+		return
+	}
+	if err := client.Ping(); err != nil {
+		resp.Diagnostics.Append(pingDiagnostics(config, url, creds, err)...)
+		return
+	}
+	if !client.Server.Detected() {
+		tflog.Warn(ctx, "Could not detect the edition and version of nexus, resources which require a specific edition or version will fail", map[string]interface{}{
+			"edition": client.Server.Edition,
+			"version": client.Server.Version,
+		})
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -98,6 +342,33 @@ func (p *NexusProvider) Resources(ctx context.Context) []func() resource.Resourc
 	return []func() resource.Resource{
 		// NewExampleResource,
 		blobstore.NewResourceBlobstoreFile,
+		blobstore.NewResourceBlobstoreS3,
+		blobstore.NewResourceBlobstoreGroup,
+		blobstore.NewResourceBlobstoreAzure,
+		blobstore.NewResourceBlobstoreCompactTask,
+		repository.NewResourceRepositoryAptHosted,
+		repository.NewResourceRepositoryAptProxy,
+		repository.NewResourceRepositoryMavenHosted,
+		repository.NewResourceRepositoryMavenProxy,
+		repository.NewResourceRepositoryMavenGroup,
+		repository.NewResourceRepositoryDockerHosted,
+		repository.NewResourceRepositoryDockerProxy,
+		repository.NewResourceRepositoryDockerGroup,
+		repository.NewResourceRepositoryNpmHosted,
+		repository.NewResourceRepositoryNpmProxy,
+		repository.NewResourceRepositoryNpmGroup,
+		repository.NewResourceRepositoryPypiHosted,
+		repository.NewResourceRepositoryPypiProxy,
+		repository.NewResourceRepositoryPypiGroup,
+		repository.NewResourceRepositoryNugetHosted,
+		repository.NewResourceRepositoryNugetProxy,
+		repository.NewResourceRepositoryNugetGroup,
+		repository.NewResourceRepositoryRubyGemsHosted,
+		repository.NewResourceRepositoryRubyGemsProxy,
+		repository.NewResourceRepositoryRubyGemsGroup,
+		repository.NewResourceRepositoryRawHosted,
+		repository.NewResourceRepositoryRawProxy,
+		repository.NewResourceRepositoryRawGroup,
 	}
 }
 
@@ -106,8 +377,33 @@ func (p *NexusProvider) DataSources(ctx context.Context) []func() datasource.Dat
 		blobstore.NewBlobStoreFileSource,
 		blobstore.NewBlobStoreListSource,
 		blobstore.NewBlobStoreGroupSource,
+		blobstore.NewBlobStoreS3Source,
+		blobstore.NewBlobStoreAzureSource,
+		repository.NewRepositoryAptHostedDatasource,
 		repository.NewRepositoryAptProxyDatasource,
-		// blobstore.NewBlobStoreFileSource,
+		repository.NewRepositoryMavenHostedDatasource,
+		repository.NewRepositoryMavenProxyDatasource,
+		repository.NewRepositoryMavenGroupDatasource,
+		repository.NewRepositoryDockerHostedDatasource,
+		repository.NewRepositoryDockerProxyDatasource,
+		repository.NewRepositoryDockerGroupDatasource,
+		repository.NewRepositoryNpmHostedDatasource,
+		repository.NewRepositoryNpmProxyDatasource,
+		repository.NewRepositoryNpmGroupDatasource,
+		repository.NewRepositoryPypiHostedDatasource,
+		repository.NewRepositoryPypiProxyDatasource,
+		repository.NewRepositoryPypiGroupDatasource,
+		repository.NewRepositoryNugetHostedDatasource,
+		repository.NewRepositoryNugetProxyDatasource,
+		repository.NewRepositoryNugetGroupDatasource,
+		repository.NewRepositoryRubyGemsHostedDatasource,
+		repository.NewRepositoryRubyGemsProxyDatasource,
+		repository.NewRepositoryRubyGemsGroupDatasource,
+		repository.NewRepositoryRawHostedDatasource,
+		repository.NewRepositoryRawProxyDatasource,
+		repository.NewRepositoryRawGroupDatasource,
+		repository.NewRepositoryListSource,
+		system.NewServerInfoSource,
 	}
 }
 
@@ -120,3 +416,168 @@ func (p *NexusProvider) Functions(ctx context.Context) []func() function.Functio
 func New() provider.Provider {
 	return &NexusProvider{}
 }
+
+// int64FromConfigOrEnv returns the configured value, or else the value of the
+// environment variable env. ok is false when neither is set or the environment
+// variable is invalid.
+func int64FromConfigOrEnv(value types.Int64, env string, attr path.Path, min int64, resp *provider.ConfigureResponse) (int64, bool) {
+	if !value.IsNull() {
+		return value.ValueInt64(), true
+	}
+	s, ok := os.LookupEnv(env)
+	if !ok || s == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || n < min {
+		resp.Diagnostics.AddAttributeError(attr, fmt.Sprintf("Invalid %s environment variable", env),
+			fmt.Sprintf("%s must be a whole number of at least %d, got %q.", env, min, s))
+		return 0, false
+	}
+	return n, true
+}
+
+// parseStatusCodes parses a comma separated list of http status codes.
+func parseStatusCodes(s string) ([]int, error) {
+	codes := []int{}
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		code, err := strconv.Atoi(field)
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("%q is not a http status code, expected a comma separated list such as \"429,503\"", field)
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func formatStatusCodes(codes []int) string {
+	s := make([]string, 0, len(codes))
+	for _, code := range codes {
+		s = append(s, strconv.Itoa(code))
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// configureTLS loads the CA bundle and the client key pair from the provider
+// configuration, or else from the environment.
+func configureTLS(config NexusProviderModel, clientConfig *nexusclient.Config) (diags diag.Diagnostics) {
+	caCertPEM, caCertFile := config.CACertPEM.ValueString(), config.CACertFile.ValueString()
+	if config.CACertPEM.IsNull() && config.CACertFile.IsNull() {
+		caCertPEM, caCertFile = os.Getenv("NEXUS_CA_CERT_PEM"), os.Getenv("NEXUS_CA_CERT_FILE")
+	}
+	switch {
+	case caCertPEM != "" && caCertFile != "":
+		diags.AddAttributeError(path.Root("ca_cert_pem"), "Conflicting CA certificate settings",
+			"Only one of ca_cert_pem (NEXUS_CA_CERT_PEM) and ca_cert_file (NEXUS_CA_CERT_FILE) can be set.")
+	case caCertPEM != "":
+		clientConfig.CACertPEM = []byte(caCertPEM)
+	case caCertFile != "":
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Unable to read CA certificate file", err.Error())
+		}
+		clientConfig.CACertPEM = pem
+	}
+
+	var err error
+	clientConfig.ClientCertPEM, err = pemOrFile(stringFromConfigOrEnv(config.ClientCert, "NEXUS_CLIENT_CERT"))
+	if err != nil {
+		diags.AddAttributeError(path.Root("client_cert"), "Unable to read client certificate", err.Error())
+	}
+	clientConfig.ClientKeyPEM, err = pemOrFile(stringFromConfigOrEnv(config.ClientKey, "NEXUS_CLIENT_KEY"))
+	if err != nil {
+		diags.AddAttributeError(path.Root("client_key"), "Unable to read client key", err.Error())
+	}
+	return
+}
+
+// stringFromConfigOrEnv returns the configured value, or else the value of the
+// environment variable env.
+// anyUnknown reports whether a value, or an element of a map or list, is
+// unknown.
+func anyUnknown(values ...attr.Value) bool {
+	for _, v := range values {
+		if v.IsUnknown() {
+			return true
+		}
+		var elements []attr.Value
+		switch v := v.(type) {
+		case types.Map:
+			for _, element := range v.Elements() {
+				elements = append(elements, element)
+			}
+		case types.List:
+			elements = v.Elements()
+		}
+		if anyUnknown(elements...) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringFromConfigOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// pemOrFile returns s if it is PEM encoded, and otherwise reads the file s.
+func pemOrFile(s string) ([]byte, error) {
+	if s == "" || strings.Contains(s, "-----BEGIN") {
+		return []byte(s), nil
+	}
+	return os.ReadFile(s)
+}
+
+// validateURL checks that the nexus url is an http or https url.
+func validateURL(config NexusProviderModel, nexusURL string) (diags diag.Diagnostics) {
+	urlSource := attributeSource(!config.URL.IsNull(), "url", "NEXUS_URL")
+	if nexusURL == "" {
+		diags.AddAttributeError(path.Root("url"), "Missing nexus URL",
+			"The provider needs the URL of nexus, such as https://nexus.example.com. "+
+				"Set the \"url\" provider attribute or the NEXUS_URL environment variable.")
+	} else if u, err := neturl.Parse(nexusURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		diags.AddAttributeError(path.Root("url"), "Invalid nexus URL",
+			fmt.Sprintf("%q set by %s is not a valid URL, expected an http or https URL such as https://nexus.example.com.", nexusURL, urlSource))
+	}
+	return
+}
+
+// pingDiagnostics explains why nexus could not be reached with the provider settings.
+func pingDiagnostics(config NexusProviderModel, nexusURL string, creds credentials, err error) (diags diag.Diagnostics) {
+	urlSource := attributeSource(!config.URL.IsNull(), "url", "NEXUS_URL")
+	var pingErr *nexusclient.PingError
+	if !errors.As(err, &pingErr) {
+		diags.AddAttributeError(path.Root("url"), "Unable to reach nexus",
+			fmt.Sprintf("Could not connect to %q set by %s: %v", nexusURL, urlSource, err))
+		return
+	}
+
+	switch pingErr.StatusCode {
+	case http.StatusUnauthorized:
+		switch creds.method {
+		case authMethodAnonymous:
+			diags.AddAttributeError(creds.attribute, "Anonymous access denied by nexus",
+				fmt.Sprintf("Nexus rejected the anonymous request enabled by %s, enable anonymous access in nexus or configure credentials.", creds.source))
+		case authMethodUserToken:
+			diags.AddAttributeError(creds.attribute, "Invalid nexus user token",
+				fmt.Sprintf("Nexus rejected the user token set by %s. User tokens require Nexus Pro with the user token realm enabled.", creds.source))
+		default:
+			diags.AddAttributeError(creds.attribute, "Invalid nexus credentials",
+				fmt.Sprintf("Nexus rejected the %s set by %s.", creds.method, creds.source))
+		}
+	case http.StatusNotFound:
+		diags.AddAttributeError(path.Root("url"), "Nexus not found",
+			fmt.Sprintf("%q set by %s does not serve the nexus REST API, check that the URL points to the nexus base path.", nexusURL, urlSource))
+	default:
+		diags.AddAttributeError(path.Root("url"), "Nexus is not available",
+			fmt.Sprintf("The status check of %q set by %s failed: %v", nexusURL, urlSource, err))
+	}
+	return
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	authMethodBasic     = "username and password"
+	authMethodUserToken = "user token"
+	authMethodBearer    = "bearer token"
+	authMethodAnonymous = "anonymous access"
+)
+
+// credentials are the resolved authentication settings of the provider.
+type credentials struct {
+	method      string
+	username    string
+	password    string
+	bearerToken string
+	// source describes where the credentials are set, for diagnostics.
+	source string
+	// attribute is the provider attribute diagnostics about the credentials point to.
+	attribute path.Path
+}
+
+// resolveCredentials picks the authentication method. Credentials set in the
+// provider block win over the environment. Within the provider block and
+// within the environment the first method set of bearer_token, user token
+// and username/password is used, anonymous access is used without any of them.
+// ConfigValidators make sure the provider block sets at most one method.
+func resolveCredentials(config NexusProviderModel) (creds credentials, diags diag.Diagnostics) {
+	configured := !config.BearerToken.IsNull() || !config.UserTokenNameCode.IsNull() || !config.UserTokenPassCode.IsNull() ||
+		!config.Username.IsNull() || !config.Password.IsNull()
+	switch {
+	case !config.BearerToken.IsNull():
+		creds = credentials{
+			method:      authMethodBearer,
+			bearerToken: config.BearerToken.ValueString(),
+			source:      attributeSource(true, "bearer_token", ""),
+			attribute:   path.Root("bearer_token"),
+		}
+	case !config.UserTokenNameCode.IsNull() || !config.UserTokenPassCode.IsNull():
+		creds = credentials{
+			method:    authMethodUserToken,
+			username:  config.UserTokenNameCode.ValueString(),
+			password:  config.UserTokenPassCode.ValueString(),
+			source:    "the \"user_token_name_code\" and \"user_token_pass_code\" provider attributes",
+			attribute: path.Root("user_token_pass_code"),
+		}
+	case !config.Username.IsNull() || !config.Password.IsNull():
+		// A missing half of the pair is still taken from the environment.
+		creds = credentials{
+			method:   authMethodBasic,
+			username: stringFromConfigOrEnv(config.Username, "NEXUS_USERNAME"),
+			password: stringFromConfigOrEnv(config.Password, "NEXUS_PASSWORD"),
+			source: fmt.Sprintf("%s and %s",
+				attributeSource(!config.Username.IsNull(), "username", "NEXUS_USERNAME"),
+				attributeSource(!config.Password.IsNull(), "password", "NEXUS_PASSWORD")),
+			attribute: path.Root("password"),
+		}
+	case config.Anonymous.ValueBool():
+		creds = credentials{
+			method:    authMethodAnonymous,
+			source:    attributeSource(true, "anonymous", ""),
+			attribute: path.Root("anonymous"),
+		}
+	default:
+		creds = environmentCredentials()
+	}
+
+	if config.Anonymous.ValueBool() && configured {
+		diags.AddAttributeError(path.Root("anonymous"), "Conflicting nexus credentials",
+			fmt.Sprintf("anonymous = true can not be combined with the %s set by %s.", creds.method, creds.source))
+		return
+	}
+	diags.Append(creds.validate()...)
+	return
+}
+
+func environmentCredentials() credentials {
+	_, bearerToken := os.LookupEnv("NEXUS_BEARER_TOKEN")
+	_, userTokenNameCode := os.LookupEnv("NEXUS_USER_TOKEN_NAME_CODE")
+	_, userTokenPassCode := os.LookupEnv("NEXUS_USER_TOKEN_PASS_CODE")
+	_, username := os.LookupEnv("NEXUS_USERNAME")
+	_, password := os.LookupEnv("NEXUS_PASSWORD")
+	switch {
+	case bearerToken:
+		return credentials{
+			method:      authMethodBearer,
+			bearerToken: os.Getenv("NEXUS_BEARER_TOKEN"),
+			source:      attributeSource(false, "", "NEXUS_BEARER_TOKEN"),
+			attribute:   path.Root("bearer_token"),
+		}
+	case userTokenNameCode || userTokenPassCode:
+		return credentials{
+			method:    authMethodUserToken,
+			username:  os.Getenv("NEXUS_USER_TOKEN_NAME_CODE"),
+			password:  os.Getenv("NEXUS_USER_TOKEN_PASS_CODE"),
+			source:    "the NEXUS_USER_TOKEN_NAME_CODE and NEXUS_USER_TOKEN_PASS_CODE environment variables",
+			attribute: path.Root("user_token_pass_code"),
+		}
+	case username || password || os.Getenv("NEXUS_ANONYMOUS") != "true":
+		return credentials{
+			method:    authMethodBasic,
+			username:  os.Getenv("NEXUS_USERNAME"),
+			password:  os.Getenv("NEXUS_PASSWORD"),
+			source:    "the NEXUS_USERNAME and NEXUS_PASSWORD environment variables",
+			attribute: path.Root("password"),
+		}
+	default:
+		return credentials{
+			method:    authMethodAnonymous,
+			source:    attributeSource(false, "", "NEXUS_ANONYMOUS"),
+			attribute: path.Root("anonymous"),
+		}
+	}
+}
+
+func (c credentials) validate() (diags diag.Diagnostics) {
+	missing := func(attribute string, env string, what string) {
+		diags.AddAttributeError(path.Root(attribute), fmt.Sprintf("Missing nexus %s", what),
+			fmt.Sprintf("Set the %q provider attribute or the %s environment variable. "+
+				"Other authentication methods are bearer_token, user_token_name_code/user_token_pass_code, "+
+				"username/password, or anonymous = true to connect without credentials.", attribute, env))
+	}
+	switch c.method {
+	case authMethodBearer:
+		if c.bearerToken == "" {
+			missing("bearer_token", "NEXUS_BEARER_TOKEN", "bearer token")
+		}
+	case authMethodUserToken:
+		if c.username == "" {
+			missing("user_token_name_code", "NEXUS_USER_TOKEN_NAME_CODE", "user token name code")
+		}
+		if c.password == "" {
+			missing("user_token_pass_code", "NEXUS_USER_TOKEN_PASS_CODE", "user token pass code")
+		}
+	case authMethodBasic:
+		if c.username == "" {
+			missing("username", "NEXUS_USERNAME", "username")
+		}
+		if c.password == "" {
+			missing("password", "NEXUS_PASSWORD", "password")
+		}
+	}
+	return
+}
+
+// attributeSource names where a provider setting comes from, for diagnostics.
+func attributeSource(configured bool, attribute string, env string) string {
+	if configured {
+		return fmt.Sprintf("the %q provider attribute", attribute)
+	}
+	return fmt.Sprintf("the %s environment variable", env)
+}
@@ -0,0 +1,205 @@
+package provider_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// authEnv are the environment variables resolveCredentials reads.
+var authEnv = []string{
+	"NEXUS_USERNAME",
+	"NEXUS_PASSWORD",
+	"NEXUS_USER_TOKEN_NAME_CODE",
+	"NEXUS_USER_TOKEN_PASS_CODE",
+	"NEXUS_BEARER_TOKEN",
+	"NEXUS_ANONYMOUS",
+}
+
+// unsetenv unsets the environment variables for the duration of the test.
+func unsetenv(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+// enableAnonymous allows reads without credentials.
+func enableAnonymous(t *testing.T, server *mocknexus.Server) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/service/rest/v1/security/anonymous",
+		strings.NewReader(`{"enabled":true,"userId":"anonymous","realmName":"NexusAuthorizingRealm"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(server.Username, server.Password)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		t.Fatalf("enable anonymous access: HTTP %d", resp.StatusCode)
+	}
+}
+
+func basicAuth(username string, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func TestProviderAuthentication(t *testing.T) {
+	server := acctest.NewServer(t)
+	server.BearerToken = "bearer-token"
+	enableAnonymous(t, server)
+	proxy := newRecordingProxy(t, server, "Authorization")
+	admin := basicAuth(server.Username, server.Password)
+
+	for _, tc := range []struct {
+		name     string
+		env      map[string]string
+		settings string
+		// authorization is the header of all requests, "" for none.
+		authorization string
+		err           string
+	}{
+		{
+			name:          "provider block beats the environment",
+			env:           map[string]string{"NEXUS_BEARER_TOKEN": "invalid", "NEXUS_USERNAME": "invalid", "NEXUS_PASSWORD": "invalid"},
+			settings:      fmt.Sprintf("username = %q\npassword = %q", server.Username, server.Password),
+			authorization: admin,
+		},
+		{
+			name:          "half of the basic credentials from the environment",
+			env:           map[string]string{"NEXUS_BEARER_TOKEN": "invalid", "NEXUS_PASSWORD": server.Password},
+			settings:      fmt.Sprintf("username = %q", server.Username),
+			authorization: admin,
+		},
+		{
+			name:          "anonymous in the provider block beats the environment",
+			env:           map[string]string{"NEXUS_USERNAME": "invalid", "NEXUS_PASSWORD": "invalid"},
+			settings:      "anonymous = true",
+			authorization: "",
+		},
+		{
+			name: "bearer token before user token and basic",
+			env: map[string]string{
+				"NEXUS_BEARER_TOKEN":         "bearer-token",
+				"NEXUS_USER_TOKEN_NAME_CODE": "invalid",
+				"NEXUS_USER_TOKEN_PASS_CODE": "invalid",
+				"NEXUS_USERNAME":             "invalid",
+				"NEXUS_PASSWORD":             "invalid",
+			},
+			authorization: "Bearer bearer-token",
+		},
+		{
+			name: "user token before basic",
+			env: map[string]string{
+				"NEXUS_USER_TOKEN_NAME_CODE": server.Username,
+				"NEXUS_USER_TOKEN_PASS_CODE": server.Password,
+				"NEXUS_USERNAME":             "invalid",
+				"NEXUS_PASSWORD":             "invalid",
+			},
+			authorization: admin,
+		},
+		{
+			name:          "basic before anonymous",
+			env:           map[string]string{"NEXUS_USERNAME": server.Username, "NEXUS_PASSWORD": server.Password, "NEXUS_ANONYMOUS": "true"},
+			authorization: admin,
+		},
+		{
+			name:          "anonymous from the environment",
+			env:           map[string]string{"NEXUS_ANONYMOUS": "true"},
+			authorization: "",
+		},
+		{
+			name:          "bearer token in the provider block",
+			settings:      `bearer_token = "bearer-token"`,
+			authorization: "Bearer bearer-token",
+		},
+		{
+			name: "empty bearer token in the environment",
+			env:  map[string]string{"NEXUS_BEARER_TOKEN": "", "NEXUS_USERNAME": server.Username, "NEXUS_PASSWORD": server.Password},
+			err:  `Missing nexus bearer token`,
+		},
+		{
+			name: "missing password",
+			env:  map[string]string{"NEXUS_USERNAME": server.Username},
+			err:  `Missing nexus password`,
+		},
+		{
+			name:     "anonymous conflicts with credentials",
+			settings: fmt.Sprintf("anonymous = true\nusername = %q\npassword = %q", server.Username, server.Password),
+			err:      `anonymous = true can not be combined with the username and password set by\s+the "username" provider attribute`,
+		},
+		{
+			name:     "bearer token conflicts with basic",
+			settings: fmt.Sprintf("bearer_token = \"bearer-token\"\nusername = %q\npassword = %q", server.Username, server.Password),
+			err:      `Invalid Attribute Combination`,
+		},
+		{
+			name:     "user token conflicts with basic",
+			settings: fmt.Sprintf("user_token_name_code = %q\nuser_token_pass_code = %q\npassword = %q", server.Username, server.Password, server.Password),
+			err:      `Invalid Attribute Combination`,
+		},
+		{
+			name:     "user token needs both codes",
+			settings: fmt.Sprintf("user_token_name_code = %q", server.Username),
+			err:      `Invalid Attribute Combination`,
+		},
+		{
+			name:     "invalid credentials",
+			settings: `bearer_token = "invalid"`,
+			err:      `Nexus rejected the bearer token set by the "bearer_token" provider\s+attribute`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			unsetenv(t, authEnv...)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+			before, _ := proxy.recorded()
+			step := resource.TestStep{
+				Config: fmt.Sprintf(`
+provider "nexus" {
+  url         = %q
+  max_retries = 0
+%s
+}
+
+data "nexus_server_info" "test" {}
+`, proxy.URL, tc.settings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "version", mocknexus.DefaultVersion),
+					func(*terraform.State) error {
+						requests, headers := proxy.recorded()
+						for i := len(before); i < len(requests); i++ {
+							if headers[i] != tc.authorization {
+								return fmt.Errorf("request of %s was sent with Authorization %q, want %q", requests[i], headers[i], tc.authorization)
+							}
+						}
+						return nil
+					},
+				),
+			}
+			if tc.err != "" {
+				step.Check = nil
+				step.ExpectError = regexp.MustCompile(tc.err)
+			}
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps:                    []resource.TestStep{step},
+			})
+		})
+	}
+}
@@ -0,0 +1,353 @@
+package provider_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// newClientCertificate returns a CA and a PEM encoded client certificate and
+// key signed by it.
+func newClientCertificate(t *testing.T) (ca *x509.Certificate, certPEM string, keyPEM string) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err = x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return ca, certPEM, keyPEM
+}
+
+func TestProviderTLS(t *testing.T) {
+	clientCA, clientCert, clientKey := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCA)
+	server := mocknexus.NewTLSServer(&tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	})
+	t.Cleanup(server.Close)
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	config := func(settings string) string {
+		return fmt.Sprintf(`
+provider "nexus" {
+  url         = %q
+  username    = %q
+  password    = %q
+  max_retries = 0
+%s
+}
+
+data "nexus_server_info" "test" {}
+`, server.URL, server.Username, server.Password, settings)
+	}
+	clientCertSettings := fmt.Sprintf("client_cert = %q\nclient_key = %q\n", clientCert, clientKey)
+
+	for _, tc := range []struct {
+		name     string
+		settings string
+		err      string
+	}{
+		{
+			name:     "untrusted server certificate",
+			settings: clientCertSettings,
+			err:      `certificate signed by unknown authority`,
+		},
+		{
+			name:     "missing client certificate",
+			settings: fmt.Sprintf("ca_cert_pem = %q\n", serverCA),
+			err:      `certificate required|bad certificate`,
+		},
+		{
+			name:     "trusted server and client certificate",
+			settings: fmt.Sprintf("ca_cert_pem = %q\n", serverCA) + clientCertSettings,
+		},
+		{
+			name:     "insecure with client certificate",
+			settings: "insecure = true\n" + clientCertSettings,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			step := resource.TestStep{
+				Config: config(tc.settings),
+				Check:  resource.TestCheckResourceAttr("data.nexus_server_info.test", "version", mocknexus.DefaultVersion),
+			}
+			if tc.err != "" {
+				step.ExpectError = regexp.MustCompile(tc.err)
+			}
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps:                    []resource.TestStep{step},
+			})
+		})
+	}
+}
+
+// recordingProxy forwards all requests to server and records their paths and
+// the value of header.
+type recordingProxy struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []string
+	headers  []string
+}
+
+func newRecordingProxy(t *testing.T, server *mocknexus.Server, header string) *recordingProxy {
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &recordingProxy{}
+	forward := httputil.NewSingleHostReverseProxy(target)
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mu.Lock()
+		p.requests = append(p.requests, r.URL.Path)
+		p.headers = append(p.headers, r.Header.Get(header))
+		p.mu.Unlock()
+		r.Host = target.Host
+		forward.ServeHTTP(w, r)
+	}))
+	t.Cleanup(p.Close)
+	return p
+}
+
+func (p *recordingProxy) recorded() (requests []string, headers []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string{}, p.requests...), append([]string{}, p.headers...)
+}
+
+func TestProviderProxyAndHeaders(t *testing.T) {
+	server := acctest.NewServer(t)
+	proxy := newRecordingProxy(t, server, "X-Tenant")
+	// Requests to loopback addresses never use a proxy, so nexus is addressed
+	// by a name only the proxy can reach.
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nexusURL := "http://nexus.test:" + serverURL.Port()
+
+	config := func(settings string) string {
+		return fmt.Sprintf(`
+provider "nexus" {
+  url         = %q
+  username    = %q
+  password    = %q
+  max_retries = 0
+  proxy_url   = %q
+  headers = {
+    X-Tenant = "team-a"
+  }
+%s
+}
+
+data "nexus_server_info" "test" {}
+`, nexusURL, server.Username, server.Password, proxy.URL, settings)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "version", mocknexus.DefaultVersion),
+					func(*terraform.State) error {
+						requests, headers := proxy.recorded()
+						if len(requests) == 0 {
+							return fmt.Errorf("no request was sent through the proxy")
+						}
+						for i, header := range headers {
+							if header != "team-a" {
+								return fmt.Errorf("request of %s was sent with X-Tenant %q", requests[i], header)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+
+	before, _ := proxy.recorded()
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// nexus.test is only reachable through the proxy.
+				Config:      config(`  no_proxy = "localhost,.test"`),
+				ExpectError: regexp.MustCompile(`nexus.test`),
+			},
+		},
+	})
+	if after, _ := proxy.recorded(); len(after) != len(before) {
+		t.Errorf("no_proxy hosts were requested through the proxy: %v", after[len(before):])
+	}
+}
+
+func TestProviderConnectionDiagnostics(t *testing.T) {
+	server := acctest.NewServer(t)
+	// A web server which is not nexus answers the status check with 404.
+	website := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(website.Close)
+	stopped := httptest.NewServer(http.NotFoundHandler())
+	stopped.Close()
+
+	for _, tc := range []struct {
+		name     string
+		url      string
+		settings string
+		err      string
+	}{
+		{
+			name: "malformed url",
+			url:  "nexus.example.com:8081",
+			err:  `Invalid\s+nexus\s+URL(.|\n)*"nexus.example.com:8081"\s+set\s+by\s+the\s+"url"\s+provider\s+attribute\s+is\s+not\s+a\s+valid\s+URL`,
+		},
+		{
+			name: "url without host",
+			url:  "https://",
+			err:  `Invalid\s+nexus\s+URL`,
+		},
+		{
+			name:     "invalid credentials",
+			url:      server.URL,
+			settings: fmt.Sprintf("username = %q\npassword = \"invalid\"", server.Username),
+			err:      `Invalid\s+nexus\s+credentials(.|\n)*Nexus\s+rejected\s+the\s+username\s+and\s+password\s+set\s+by\s+the\s+"username"\s+provider\s+attribute\s+and\s+the\s+"password"\s+provider\s+attribute`,
+		},
+		{
+			name:     "not nexus",
+			url:      website.URL,
+			settings: fmt.Sprintf("username = %q\npassword = %q", server.Username, server.Password),
+			err:      `Nexus\s+not\s+found(.|\n)*does\s+not\s+serve\s+the\s+nexus\s+REST\s+API`,
+		},
+		{
+			name:     "unreachable",
+			url:      stopped.URL,
+			settings: fmt.Sprintf("username = %q\npassword = %q", server.Username, server.Password),
+			err:      `Unable\s+to\s+reach\s+nexus(.|\n)*Could\s+not\s+connect\s+to\s+"` + regexp.QuoteMeta(stopped.URL) + `"\s+set\s+by\s+the\s+"url"\s+provider\s+attribute`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			unsetenv(t, "NEXUS_URL")
+			unsetenv(t, authEnv...)
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+provider "nexus" {
+  url         = %q
+  max_retries = 0
+%s
+}
+
+data "nexus_server_info" "test" {}
+`, tc.url, tc.settings),
+						ExpectError: regexp.MustCompile(tc.err),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestProviderUnknownSettings(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	for name, setting := range map[string]string{
+		"timeout":     `timeout = terraform_data.setting.output`,
+		"headers":     `headers = { X-Tenant = terraform_data.setting.output }`,
+		"proxy_url":   `proxy_url = terraform_data.setting.output`,
+		"ca_cert_pem": `ca_cert_pem = terraform_data.setting.output`,
+		"insecure":    `insecure = terraform_data.setting.output`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						// The output of terraform_data is only known after apply.
+						Config: fmt.Sprintf(`
+resource "terraform_data" "setting" {
+  input = "unknown"
+}
+
+provider "nexus" {
+  url         = %q
+  username    = %q
+  password    = %q
+  max_retries = 0
+  %s
+}
+
+data "nexus_server_info" "test" {}
+`, server.URL, server.Username, server.Password, setting),
+						ExpectError: regexp.MustCompile(`Unknown\s+nexus\s+connection\s+settings`),
+					},
+				},
+			})
+		})
+	}
+}
@@ -2,34 +2,34 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
 )
 
-// var _ datasource.DataSource = &RepositoryAptHostedDatasource{}
+var _ datasource.DataSource = &RepositoryAptHostedDatasource{}
 
-// func NewRepositoryAptHostedDatasource() datasource.DataSource {
-// 	return &RepositoryAptHostedDatasource{}
-// }
+func NewRepositoryAptHostedDatasource() datasource.DataSource {
+	return &RepositoryAptHostedDatasource{}
+}
 
 type RepositoryAptHostedDatasource struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type RepositoryAptHostedSourceModel struct {
-	Id                    types.String    `tfsdk:"id"`
-	Name                  types.String    `tfsdk:"name"`
-	Online                types.Bool      `tfsdk:"online"`
-	Cleanup               CleanupModel    `tfsdk:"cleanup"`
-	Component             ComponentModel  `tfsdk:"component"`
-	Path                  types.String    `tfsdk:"path"`
-	BlobCount             types.Int64     `tfsdk:"blob_count"`
-	AvailableSpaceInBytes types.Int64     `tfsdk:"available_space_in_bytes"`
-	TotalSizeInBytes      types.Int64     `tfsdk:"total_size_in_bytes"`
-	SoftQuota             *SoftQuotaModel `tfsdk:"soft_quota"`
+	Id           types.String    `tfsdk:"id"`
+	Name         types.String    `tfsdk:"name"`
+	Online       types.Bool      `tfsdk:"online"`
+	Distribution types.String    `tfsdk:"distribution"`
+	Cleanup      *CleanupModel   `tfsdk:"cleanup"`
+	Component    *ComponentModel `tfsdk:"component"`
+	Storage      *StorageModel   `tfsdk:"storage"`
 }
 type CleanupModel struct {
 	PolicyNames []types.String `tfsdk:"policy_names"`
@@ -45,19 +45,16 @@ type StorageModel struct {
 	WritePolicy                 types.String `tfsdk:"write_policy"`
 }
 
-type SoftQuotaModel struct {
-	Limit types.Int64  `tfsdk:"limit"`
-	Type  types.String `tfsdk:"type"`
-}
-
 func (d *RepositoryAptHostedDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_Repository_file"
+	resp.TypeName = req.ProviderTypeName + "_repository_apt_hosted"
 }
 
 func (d *RepositoryAptHostedDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Use this data source to get an existing apt repository.",
-		MarkdownDescription: "Use this data source to get an existing apt repository.",
+		Description: "Use this data source to get an existing hosted apt repository. " +
+			"The signing keypair and passphrase are never returned by the nexus api.",
+		MarkdownDescription: "Use this data source to get an existing hosted apt repository. " +
+			"The signing keypair and passphrase are never returned by the nexus api.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description:         "Used to identify data source at nexus",
@@ -126,62 +123,70 @@ func (d *RepositoryAptHostedDatasource) Schema(ctx context.Context, req datasour
 				MarkdownDescription: "Distribution to fetch",
 				Computed:            true,
 			},
-			"signing": schema.SingleNestedAttribute{
-				Description:         "Contains signing data of repositores",
-				MarkdownDescription: "Contains signing data of repositores",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"keypair": schema.StringAttribute{
-						Description: `PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)
-							If passphrase is unset, the keypair cannot be read from the nexus api.
-							When reading the resource, the keypair will be read from the previous state,
-							so external changes won't be detected in this case.`,
-						MarkdownDescription: `PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)
-							If passphrase is unset, the keypair cannot be read from the nexus api.
-							When reading the resource, the keypair will be read from the previous state,
-							so external changes won't be detected in this case.`,
-						Computed:  true,
-						Sensitive: true,
-					},
-					"passphrase": schema.StringAttribute{
-						Description: `Passphrase to access PGP signing key.
-							This value cannot be read from the nexus api.
-							When reading the resource, the value will be read from the previous state,
-							so external changes won't be detected.`,
-						MarkdownDescription: `Passphrase to access PGP signing key.
-							This value cannot be read from the nexus api.
-							When reading the resource, the value will be read from the previous state,
-							so external changes won't be detected.`,
-						Computed:  true,
-						Sensitive: true,
-					},
-				},
-			},
 		},
 	}
 }
 
-// func (d *RepositoryAptHostedDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-// 	if req.ProviderData == nil {
-// 		return
-// 	}
-// 	client, ok := req.ProviderData.(*nexus3.NexusClient)
-// 	if !ok {
-// 		resp.Diagnostics.AddError(
-// 			"Unexpected Data Source Configure Type",
-// 			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-// 		)
-// 		return
-// 	}
-// 	d.client = client
-// }
-
-// func (d *RepositoryAptHostedDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-
-// 	// var state, newState RepositoryAptHostedSourceModel
-
-// 	// tflog.Trace(ctx, "read a RepositoryAptHosted data source")
-// 	// resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
-// }
-
-// func (d *RepositoryAptHostedDatasource) getState(name string)
+func (d *RepositoryAptHostedDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryAptHostedDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryAptHostedSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt hosted datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read an apt hosted data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryAptHostedDatasource) getState(name string) (data RepositoryAptHostedSourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	repo, err := d.client.Repository.Apt.Hosted.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryAptHostedSourceModel{
+		Id:           types.StringValue(repo.Name),
+		Name:         types.StringValue(repo.Name),
+		Online:       types.BoolValue(repo.Online),
+		Distribution: types.StringValue(repo.Apt.Distribution),
+		Storage:      flattenHostedStorage(repo.Storage),
+		Cleanup:      &CleanupModel{PolicyNames: []types.String{}},
+		Component:    &ComponentModel{ProprietaryComponents: types.BoolValue(false)},
+	}
+	if repo.Cleanup != nil {
+		for _, item := range repo.Cleanup.PolicyNames {
+			data.Cleanup.PolicyNames = append(data.Cleanup.PolicyNames, types.StringValue(item))
+		}
+	}
+	if repo.Component != nil {
+		data.Component.ProprietaryComponents = types.BoolValue(repo.Component.ProprietaryComponents)
+	}
+	return
+}
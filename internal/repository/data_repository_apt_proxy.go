@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/nduyphuong/go-nexus-client/nexus3"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
 )
 
 var _ datasource.DataSource = &RepositoryAptProxyDatasource{}
@@ -19,7 +19,7 @@ func NewRepositoryAptProxyDatasource() datasource.DataSource {
 }
 
 type RepositoryAptProxyDatasource struct {
-	client *nexus3.NexusClient
+	client *nexusclient.Client
 }
 
 type RepositoryAptProxySourceModel struct {
@@ -79,7 +79,7 @@ type HttpClientConnectionModel struct {
 }
 
 func (d *RepositoryAptProxyDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_apt_proxy"
+	resp.TypeName = req.ProviderTypeName + "_repository_apt_proxy"
 }
 
 func (d *RepositoryAptProxyDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -147,93 +147,7 @@ func (d *RepositoryAptProxyDatasource) Schema(ctx context.Context, req datasourc
 				MarkdownDescription: "The name of the routing rule assigned to this repository",
 				Computed:            true,
 			},
-			"http_client": schema.SingleNestedAttribute{
-				Description:         "HTTP Client configuration for proxy repositories",
-				MarkdownDescription: "HTTP Client configuration for proxy repositories",
-				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"authentication": schema.SingleNestedAttribute{
-						Description:         "Authentication configuration of the HTTP client",
-						MarkdownDescription: "Authentication configuration of the HTTP client",
-						Computed:            true,
-						Attributes: map[string]schema.Attribute{
-							"type": schema.StringAttribute{
-								Description:         "Authentication type. Possible values: `ntlm` or `username`",
-								MarkdownDescription: "Authentication type. Possible values: `ntlm` or `username`",
-								Computed:            true,
-							},
-							"username": schema.StringAttribute{
-								Description:         "The username used by the proxy repository",
-								MarkdownDescription: "The username used by the proxy repository",
-								Computed:            true,
-							},
-							"password": schema.StringAttribute{
-								Description:         "The password used by the proxy repository",
-								MarkdownDescription: "The password used by the proxy repository",
-								Computed:            true,
-								// Sensitive:           true,
-							},
-							"ntlm_domain": schema.StringAttribute{
-								Description:         "The ntlm domain to connect",
-								MarkdownDescription: "The ntlm domain to connect",
-								Computed:            true,
-							},
-							"ntlm_host": schema.StringAttribute{
-								Description:         "The ntlm host to connect",
-								MarkdownDescription: "The ntlm host to connect",
-								Computed:            true,
-							},
-						},
-					},
-					"connection": schema.SingleNestedAttribute{
-						Description:         "Connection configuration of the HTTP client",
-						MarkdownDescription: "Connection configuration of the HTTP client",
-						Computed:            true,
-						Attributes: map[string]schema.Attribute{
-							"enable_circular_redirects": schema.BoolAttribute{
-								Description:         "Whether to enable redirects to the same location (may be required by some servers)",
-								MarkdownDescription: "Whether to enable redirects to the same location (may be required by some servers)",
-								Computed:            true,
-							},
-							"enable_cookies": schema.BoolAttribute{
-								Description:         "Whether to allow cookies to be stored and used",
-								MarkdownDescription: "Whether to allow cookies to be stored and used",
-								Computed:            true,
-							},
-							"retries": schema.Int64Attribute{
-								Description:         "Total retries if the initial connection attempt suffers a timeout",
-								MarkdownDescription: "Total retries if the initial connection attempt suffers a timeout",
-								Computed:            true,
-							},
-							"timeout": schema.Int64Attribute{
-								Description:         "Seconds to wait for activity before stopping and retrying the connection",
-								MarkdownDescription: "Seconds to wait for activity before stopping and retrying the connection",
-								Computed:            true,
-							},
-							"user_agent_suffix": schema.StringAttribute{
-								Description:         "Custom fragment to append to User-Agent header in HTTP requests",
-								MarkdownDescription: "Custom fragment to append to User-Agent header in HTTP requests",
-								Computed:            true,
-							},
-							"use_trust_store": schema.BoolAttribute{
-								Description:         "Use certificates stored in the Nexus Repository Manager truststore to connect to external systems",
-								MarkdownDescription: "Use certificates stored in the Nexus Repository Manager truststore to connect to external systems",
-								Computed:            true,
-							},
-						},
-					},
-					"auto_block": schema.BoolAttribute{
-						Description:         "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
-						MarkdownDescription: "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
-						Computed:            true,
-					},
-					"blocked": schema.BoolAttribute{
-						Description:         "Whether to block outbound connections on the repository",
-						MarkdownDescription: "Whether to block outbound connections on the repository",
-						Computed:            true,
-					},
-				},
-			},
+			"http_client": httpClientDataSourceSchema(false),
 			"negative_cache": schema.SingleNestedAttribute{
 				Description:         "Configuration of the negative cache handling",
 				MarkdownDescription: "Configuration of the negative cache handling",
@@ -281,11 +195,11 @@ func (d *RepositoryAptProxyDatasource) Configure(ctx context.Context, req dataso
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*nexus3.NexusClient)
+	client, ok := req.ProviderData.(*nexusclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *nexus3.NexusClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -306,6 +220,7 @@ func (d *RepositoryAptProxyDatasource) Read(ctx context.Context, req datasource.
 	state, err := d.getState(state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Get apt proxy datasource failed", err.Error())
+		return
 	}
 	tflog.Trace(ctx, "read a apt proxy data source")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -343,11 +258,7 @@ func (d *RepositoryAptProxyDatasource) getState(name string) (data RepositoryApt
 			BlobStoreName:               types.StringValue(repo.BlobStoreName),
 			StrictContentTypeValidation: types.BoolValue(repo.StrictContentTypeValidation),
 		},
-		HttpClient: &HttpClientModel{
-			AutoBlock:      types.BoolValue(repo.HTTPClient.AutoBlock),
-			Blocked:        types.BoolValue(repo.HTTPClient.Blocked),
-			Authentication: &HttpClientAuthenticationModel{},
-		},
+		HttpClient: flattenHTTPClient(repo.HTTPClient, nil),
 		Cleanup: &CleanupModel{
 			PolicyNames: []types.String{types.StringValue("")},
 		},
@@ -362,28 +273,6 @@ func (d *RepositoryAptProxyDatasource) getState(name string) (data RepositoryApt
 		}
 	}
 
-	if repo.HTTPClient.Authentication != nil {
-		data.HttpClient = &HttpClientModel{
-			Authentication: &HttpClientAuthenticationModel{
-				NtlmDomain: types.StringValue(repo.HTTPClient.Authentication.NTLMDomain),
-				NtlmHost:   types.StringValue(repo.HTTPClient.Authentication.NTLMHost),
-				Type:       types.StringValue(string(repo.HTTPClient.Authentication.Type)),
-				Username:   types.StringValue(repo.HTTPClient.Authentication.Username),
-				Password:   types.StringValue(repo.HTTPClient.Authentication.Password),
-			},
-		}
-	}
-	if repo.HTTPClient.Connection != nil {
-		data.HttpClient.Connection = &HttpClientConnectionModel{
-			EnableCircularRedirects: types.BoolValue(GetValue(repo.HTTPClient.Connection.EnableCircularRedirects)),
-			EnableCookies:           types.BoolValue(GetValue(repo.HTTPClient.Connection.EnableCookies)),
-			Retries:                 types.Int64Value(int64(GetValue(repo.HTTPClient.Connection.Retries))),
-			Timeout:                 types.Int64Value(int64(GetValue(repo.HTTPClient.Connection.Timeout))),
-			UseTrustStore:           types.BoolValue(GetValue(repo.HTTPClient.Connection.UseTrustStore)),
-			UserAgentSuffix:         types.StringValue(repo.HTTPClient.Connection.UserAgentSuffix),
-		}
-	}
-
 	return
 }
 
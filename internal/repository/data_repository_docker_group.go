@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &RepositoryDockerGroupDatasource{}
+
+func NewRepositoryDockerGroupDatasource() datasource.DataSource {
+	return &RepositoryDockerGroupDatasource{}
+}
+
+type RepositoryDockerGroupDatasource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryDockerGroupSourceModel struct {
+	Id      types.String            `tfsdk:"id"`
+	Name    types.String            `tfsdk:"name"`
+	Online  types.Bool              `tfsdk:"online"`
+	Docker  *DockerModel            `tfsdk:"docker"`
+	Group   *GroupDeployModel       `tfsdk:"group"`
+	Storage *StorageDataSourceModel `tfsdk:"storage"`
+}
+
+func (d *RepositoryDockerGroupDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_docker_group"
+}
+
+func (d *RepositoryDockerGroupDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get an existing group docker repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository",
+				Required:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "Whether this repository accepts incoming requests",
+				Computed:    true,
+			},
+			"docker":  dockerDataSourceSchema(),
+			"group":   groupDeployDataSourceSchema(),
+			"storage": proxyStorageDataSourceSchema(),
+		},
+	}
+}
+
+func (d *RepositoryDockerGroupDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryDockerGroupDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryDockerGroupSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker group datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read a docker group data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryDockerGroupDatasource) getState(name string) (data RepositoryDockerGroupSourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	var repo dockerGroupRepository
+	err = getRepository(d.client, repository.RepositoryFormatDocker, repository.RepositoryTypeGroup, name, &repo)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryDockerGroupSourceModel{
+		Id:      types.StringValue(repo.Name),
+		Name:    types.StringValue(repo.Name),
+		Online:  types.BoolValue(repo.Online),
+		Docker:  flattenDocker(repo.Docker),
+		Group:   flattenGroupDeploy(repo.Group),
+		Storage: flattenProxyStorage(repo.Storage),
+	}
+	return
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &RepositoryDockerProxyDatasource{}
+
+func NewRepositoryDockerProxyDatasource() datasource.DataSource {
+	return &RepositoryDockerProxyDatasource{}
+}
+
+type RepositoryDockerProxyDatasource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryDockerProxySourceModel struct {
+	Id            types.String            `tfsdk:"id"`
+	Name          types.String            `tfsdk:"name"`
+	Online        types.Bool              `tfsdk:"online"`
+	Docker        *DockerModel            `tfsdk:"docker"`
+	DockerProxy   *DockerProxyModel       `tfsdk:"docker_proxy"`
+	RoutingRule   types.String            `tfsdk:"routing_rule"`
+	Cleanup       *CleanupModel           `tfsdk:"cleanup"`
+	Storage       *StorageDataSourceModel `tfsdk:"storage"`
+	Proxy         *ProxyModel             `tfsdk:"proxy"`
+	NegativeCache *NegativeCacheModel     `tfsdk:"negative_cache"`
+	HttpClient    *HttpClientModel        `tfsdk:"http_client"`
+}
+
+func (d *RepositoryDockerProxyDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_docker_proxy"
+}
+
+func (d *RepositoryDockerProxyDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get an existing proxy docker repository. The authentication password is never returned by the nexus api.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository",
+				Required:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "Whether this repository accepts incoming requests",
+				Computed:    true,
+			},
+			"docker":       dockerDataSourceSchema(),
+			"docker_proxy": dockerProxyDataSourceSchema(),
+			"routing_rule": schema.StringAttribute{
+				Description: "The name of the routing rule assigned to this repository",
+				Computed:    true,
+			},
+			"cleanup":        cleanupDataSourceSchema(),
+			"storage":        proxyStorageDataSourceSchema(),
+			"proxy":          proxyDataSourceSchema(),
+			"negative_cache": negativeCacheDataSourceSchema(),
+			"http_client":    httpClientDataSourceSchema(false),
+		},
+	}
+}
+
+func (d *RepositoryDockerProxyDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryDockerProxyDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryDockerProxySourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker proxy datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read a docker proxy data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryDockerProxyDatasource) getState(name string) (data RepositoryDockerProxySourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	var repo dockerProxyRepository
+	err = getRepository(d.client, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy, name, &repo)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryDockerProxySourceModel{
+		Id:            types.StringValue(repo.Name),
+		Name:          types.StringValue(repo.Name),
+		Online:        types.BoolValue(repo.Online),
+		Docker:        flattenDocker(repo.Docker),
+		DockerProxy:   flattenDockerProxy(repo.DockerProxy),
+		RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+		Cleanup:       flattenCleanup(repo.Cleanup, nil),
+		Storage:       flattenProxyStorage(repo.Storage),
+		Proxy:         flattenProxy(repo.Proxy),
+		NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		HttpClient:    flattenHTTPClient(repo.HTTPClient, nil),
+	}
+	return
+}
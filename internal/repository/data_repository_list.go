@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+var _ datasource.DataSource = &RepositoryListSource{}
+
+func NewRepositoryListSource() datasource.DataSource {
+	return &RepositoryListSource{}
+}
+
+type RepositoryListSource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryListSourceModel struct {
+	Id            types.String                     `tfsdk:"id"`
+	Format        types.String                     `tfsdk:"format"`
+	Type          types.String                     `tfsdk:"type"`
+	NameRegex     types.String                     `tfsdk:"name_regex"`
+	BlobStoreName types.String                     `tfsdk:"blob_store_name"`
+	Items         []*RepositoryListSourceItemModel `tfsdk:"items"`
+}
+
+type RepositoryListSourceItemModel struct {
+	Name          types.String   `tfsdk:"name"`
+	Format        types.String   `tfsdk:"format"`
+	Type          types.String   `tfsdk:"type"`
+	URL           types.String   `tfsdk:"url"`
+	Online        types.Bool     `tfsdk:"online"`
+	BlobStoreName types.String   `tfsdk:"blob_store_name"`
+	RemoteURL     types.String   `tfsdk:"remote_url"`
+	MemberNames   []types.String `tfsdk:"member_names"`
+}
+
+func (d *RepositoryListSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_list"
+}
+
+func (d *RepositoryListSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get a list with all repositories, optionally filtered by format, type, name or blob store.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Only return repositories of this format, as reported by nexus, e.g. `npm`, `maven2` or `docker`",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return repositories of this type. Possible values: `hosted`, `proxy` or `group`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(repository.RepositoryTypeHosted, repository.RepositoryTypeProxy, repository.RepositoryTypeGroup),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return repositories whose name matches this regular expression",
+				Optional:    true,
+				Validators: []validator.String{
+					tfutil.RegexValidator{},
+				},
+			},
+			"blob_store_name": schema.StringAttribute{
+				Description: "Only return repositories which store their contents in this blob store",
+				Optional:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "A List of all repositories matching the filters, ordered by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Repository name",
+							Computed:    true,
+						},
+						"format": schema.StringAttribute{
+							Description: "Repository format",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Repository type. Possible values: `hosted`, `proxy` or `group`",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							Description: "Repository URL",
+							Computed:    true,
+						},
+						"online": schema.BoolAttribute{
+							Description: "Whether this repository accepts incoming requests",
+							Computed:    true,
+						},
+						"blob_store_name": schema.StringAttribute{
+							Description: "Blob store used to store repository contents",
+							Computed:    true,
+						},
+						"remote_url": schema.StringAttribute{
+							Description: "Location of the remote repository being proxied, only set for proxy repositories",
+							Computed:    true,
+						},
+						"member_names": schema.ListAttribute{
+							Description: "Member repositories names, only set for group repositories",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepositoryListSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryListSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryListSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	repositories, err := listRepositorySettings(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Get repository list failed", err.Error())
+		return
+	}
+	newState := RepositoryListSourceModel{
+		Id:            types.StringValue("repositories"),
+		Format:        state.Format,
+		Type:          state.Type,
+		NameRegex:     state.NameRegex,
+		BlobStoreName: state.BlobStoreName,
+		Items:         []*RepositoryListSourceItemModel{},
+	}
+	for _, repo := range repositories {
+		blobStoreName := ""
+		if repo.Storage != nil {
+			blobStoreName = repo.Storage.BlobStoreName
+		}
+		if !state.Format.IsNull() && !strings.EqualFold(state.Format.ValueString(), repo.Format) {
+			continue
+		}
+		if !state.Type.IsNull() && state.Type.ValueString() != repo.Type {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(repo.Name) {
+			continue
+		}
+		if !state.BlobStoreName.IsNull() && state.BlobStoreName.ValueString() != blobStoreName {
+			continue
+		}
+
+		item := &RepositoryListSourceItemModel{
+			Name:          types.StringValue(repo.Name),
+			Format:        types.StringValue(repo.Format),
+			Type:          types.StringValue(repo.Type),
+			URL:           tfutil.StringValueOrNull(repo.URL),
+			Online:        types.BoolValue(repo.Online),
+			BlobStoreName: tfutil.StringValueOrNull(blobStoreName),
+			RemoteURL:     types.StringNull(),
+		}
+		if repo.Proxy != nil {
+			item.RemoteURL = tfutil.StringValueOrNull(repo.Proxy.RemoteURL)
+		}
+		if repo.Group != nil {
+			item.MemberNames = []types.String{}
+			for _, member := range repo.Group.MemberNames {
+				item.MemberNames = append(item.MemberNames, types.StringValue(member))
+			}
+		}
+		newState.Items = append(newState.Items, item)
+	}
+	sort.Slice(newState.Items, func(i, j int) bool {
+		return newState.Items[i].Name.ValueString() < newState.Items[j].Name.ValueString()
+	})
+
+	tflog.Trace(ctx, "read a RepositoryList data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,116 @@
+package repository_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestDataSourceRepositoryList(t *testing.T) {
+	server := acctest.NewServer(t)
+	request(t, server, http.MethodPost, "v1/blobstores/file", `{"name": "primary", "path": "primary"}`)
+	request(t, server, http.MethodPost, "v1/blobstores/file", `{"name": "secondary", "path": "secondary"}`)
+	request(t, server, http.MethodPost, "v1/repositories/raw/hosted", `{
+  "name": "raw-hosted",
+  "online": true,
+  "storage": {"blobStoreName": "primary", "strictContentTypeValidation": false, "writePolicy": "ALLOW"}
+}`)
+	request(t, server, http.MethodPost, "v1/repositories/raw/proxy", `{
+  "name": "raw-proxy",
+  "online": true,
+  "storage": {"blobStoreName": "primary", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://example.com/raw/", "contentMaxAge": 1440, "metadataMaxAge": 1440}
+}`)
+	request(t, server, http.MethodPost, "v1/repositories/maven/hosted", `{
+  "name": "maven-releases",
+  "online": true,
+  "storage": {"blobStoreName": "secondary", "strictContentTypeValidation": true, "writePolicy": "ALLOW_ONCE"}
+}`)
+	request(t, server, http.MethodPost, "v1/repositories/maven/proxy", `{
+  "name": "maven-central",
+  "online": false,
+  "storage": {"blobStoreName": "secondary", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://repo1.maven.org/maven2/", "contentMaxAge": 1440, "metadataMaxAge": 1440}
+}`)
+	request(t, server, http.MethodPost, "v1/repositories/npm/group", `{
+  "name": "npm-group",
+  "online": true,
+  "storage": {"blobStoreName": "primary", "strictContentTypeValidation": true},
+  "group": {"memberNames": ["npm-hosted", "npm-proxy"]}
+}`)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "nexus_repository_list" "all" {}
+
+data "nexus_repository_list" "format" {
+  format = "maven2"
+}
+
+data "nexus_repository_list" "type" {
+  type = "proxy"
+}
+
+data "nexus_repository_list" "name_regex" {
+  name_regex = "^raw-"
+}
+
+data "nexus_repository_list" "blob_store_name" {
+  blob_store_name = "primary"
+}
+
+data "nexus_repository_list" "combined" {
+  format          = "RAW"
+  type            = "hosted"
+  blob_store_name = "primary"
+}
+
+data "nexus_repository_list" "none" {
+  format = "npm"
+  type   = "hosted"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.#", "5"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.0.name", "maven-central"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.0.format", "maven2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.0.type", "proxy"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.0.online", "false"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.0.remote_url", "https://repo1.maven.org/maven2/"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.2.name", "npm-group"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.2.member_names.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.2.member_names.0", "npm-hosted"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.all", "items.4.name", "raw-proxy"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.format", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.format", "items.0.name", "maven-central"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.format", "items.1.name", "maven-releases"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.type", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.type", "items.0.name", "maven-central"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.type", "items.1.name", "raw-proxy"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.name_regex", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.name_regex", "items.0.name", "raw-hosted"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.name_regex", "items.1.name", "raw-proxy"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.blob_store_name", "items.#", "3"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.blob_store_name", "items.0.name", "npm-group"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.blob_store_name", "items.1.name", "raw-hosted"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.blob_store_name", "items.2.name", "raw-proxy"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.combined", "items.#", "1"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.combined", "items.0.name", "raw-hosted"),
+					resource.TestCheckResourceAttr("data.nexus_repository_list.combined", "items.0.blob_store_name", "primary"),
+
+					resource.TestCheckResourceAttr("data.nexus_repository_list.none", "items.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &RepositoryNpmGroupDatasource{}
+
+func NewRepositoryNpmGroupDatasource() datasource.DataSource {
+	return &RepositoryNpmGroupDatasource{}
+}
+
+type RepositoryNpmGroupDatasource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryNpmGroupSourceModel struct {
+	Id      types.String            `tfsdk:"id"`
+	Name    types.String            `tfsdk:"name"`
+	Online  types.Bool              `tfsdk:"online"`
+	Group   *GroupDeployModel       `tfsdk:"group"`
+	Storage *StorageDataSourceModel `tfsdk:"storage"`
+}
+
+func (d *RepositoryNpmGroupDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_npm_group"
+}
+
+func (d *RepositoryNpmGroupDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get an existing group npm repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository",
+				Required:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "Whether this repository accepts incoming requests",
+				Computed:    true,
+			},
+			"group":   groupDeployDataSourceSchema(),
+			"storage": proxyStorageDataSourceSchema(),
+		},
+	}
+}
+
+func (d *RepositoryNpmGroupDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryNpmGroupDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryNpmGroupSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get npm group datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read a npm group data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryNpmGroupDatasource) getState(name string) (data RepositoryNpmGroupSourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	repo, err := d.client.Repository.Npm.Group.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryNpmGroupSourceModel{
+		Id:      types.StringValue(repo.Name),
+		Name:    types.StringValue(repo.Name),
+		Online:  types.BoolValue(repo.Online),
+		Group:   flattenGroupDeploy(repo.Group),
+		Storage: flattenProxyStorage(repo.Storage),
+	}
+	return
+}
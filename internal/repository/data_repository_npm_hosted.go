@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &RepositoryNpmHostedDatasource{}
+
+func NewRepositoryNpmHostedDatasource() datasource.DataSource {
+	return &RepositoryNpmHostedDatasource{}
+}
+
+type RepositoryNpmHostedDatasource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryNpmHostedSourceModel struct {
+	Id        types.String    `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Online    types.Bool      `tfsdk:"online"`
+	Cleanup   *CleanupModel   `tfsdk:"cleanup"`
+	Component *ComponentModel `tfsdk:"component"`
+	Storage   *StorageModel   `tfsdk:"storage"`
+}
+
+func (d *RepositoryNpmHostedDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_npm_hosted"
+}
+
+func (d *RepositoryNpmHostedDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get an existing hosted npm repository.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository",
+				Required:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "Whether this repository accepts incoming requests",
+				Computed:    true,
+			},
+			"cleanup":   cleanupDataSourceSchema(),
+			"component": componentDataSourceSchema(),
+			"storage":   hostedStorageDataSourceSchema(),
+		},
+	}
+}
+
+func (d *RepositoryNpmHostedDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryNpmHostedDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryNpmHostedSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get npm hosted datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read a npm hosted data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryNpmHostedDatasource) getState(name string) (data RepositoryNpmHostedSourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	repo, err := d.client.Repository.Npm.Hosted.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryNpmHostedSourceModel{
+		Id:        types.StringValue(repo.Name),
+		Name:      types.StringValue(repo.Name),
+		Online:    types.BoolValue(repo.Online),
+		Cleanup:   flattenCleanup(repo.Cleanup, nil),
+		Component: flattenComponent(repo.Component, nil),
+		Storage:   flattenHostedStorage(repo.Storage),
+	}
+	return
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &RepositoryNugetProxyDatasource{}
+
+func NewRepositoryNugetProxyDatasource() datasource.DataSource {
+	return &RepositoryNugetProxyDatasource{}
+}
+
+type RepositoryNugetProxyDatasource struct {
+	client *nexusclient.Client
+}
+
+type RepositoryNugetProxySourceModel struct {
+	Id                   types.String            `tfsdk:"id"`
+	Name                 types.String            `tfsdk:"name"`
+	Online               types.Bool              `tfsdk:"online"`
+	NugetVersion         types.String            `tfsdk:"nuget_version"`
+	QueryCacheItemMaxAge types.Int64             `tfsdk:"query_cache_item_max_age"`
+	RoutingRule          types.String            `tfsdk:"routing_rule"`
+	Cleanup              *CleanupModel           `tfsdk:"cleanup"`
+	Storage              *StorageDataSourceModel `tfsdk:"storage"`
+	Proxy                *ProxyModel             `tfsdk:"proxy"`
+	NegativeCache        *NegativeCacheModel     `tfsdk:"negative_cache"`
+	HttpClient           *HttpClientModel        `tfsdk:"http_client"`
+}
+
+func (d *RepositoryNugetProxyDatasource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_nuget_proxy"
+}
+
+func (d *RepositoryNugetProxyDatasource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get an existing proxy nuget repository. The authentication password is never returned by the nexus api.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository",
+				Required:    true,
+			},
+			"online": schema.BoolAttribute{
+				Description: "Whether this repository accepts incoming requests",
+				Computed:    true,
+			},
+			"nuget_version": schema.StringAttribute{
+				Description: "Nuget protocol version",
+				Computed:    true,
+			},
+			"query_cache_item_max_age": schema.Int64Attribute{
+				Description: "How long to cache query results from the proxied repository (in seconds)",
+				Computed:    true,
+			},
+			"routing_rule": schema.StringAttribute{
+				Description: "The name of the routing rule assigned to this repository",
+				Computed:    true,
+			},
+			"cleanup":        cleanupDataSourceSchema(),
+			"storage":        proxyStorageDataSourceSchema(),
+			"proxy":          proxyDataSourceSchema(),
+			"negative_cache": negativeCacheDataSourceSchema(),
+			"http_client":    httpClientDataSourceSchema(false),
+		},
+	}
+}
+
+func (d *RepositoryNugetProxyDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *RepositoryNugetProxyDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RepositoryNugetProxySourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state, err := d.getState(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get nuget proxy datasource failed", err.Error())
+		return
+	}
+	tflog.Trace(ctx, "read a nuget proxy data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *RepositoryNugetProxyDatasource) getState(name string) (data RepositoryNugetProxySourceModel, err error) {
+
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+
+	repo, err := d.client.Repository.Nuget.Proxy.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryNugetProxySourceModel{
+		Id:                   types.StringValue(repo.Name),
+		Name:                 types.StringValue(repo.Name),
+		Online:               types.BoolValue(repo.Online),
+		NugetVersion:         types.StringValue(string(repo.NugetProxy.NugetVersion)),
+		QueryCacheItemMaxAge: types.Int64Value(int64(repo.NugetProxy.QueryCacheItemMaxAge)),
+		RoutingRule:          flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+		Cleanup:              flattenCleanup(repo.Cleanup, nil),
+		Storage:              flattenProxyStorage(repo.Storage),
+		Proxy:                flattenProxy(repo.Proxy),
+		NegativeCache:        flattenNegativeCache(repo.NegativeCache),
+		HttpClient:           flattenHTTPClient(repo.HTTPClient, nil),
+	}
+	return
+}
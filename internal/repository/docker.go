@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// The nexus client types of the docker repositories lack the subdomain and
+// the foreign layer settings, the docker resources use the types below with
+// createRepository, getRepository and updateRepository instead.
+
+type dockerHostedRepository struct {
+	Name    string                   `json:"name"`
+	Online  bool                     `json:"online"`
+	Storage repository.HostedStorage `json:"storage"`
+	Docker  docker                   `json:"docker"`
+
+	Cleanup   *repository.Cleanup   `json:"cleanup,omitempty"`
+	Component *repository.Component `json:"component,omitempty"`
+}
+
+type dockerProxyRepository struct {
+	Name          string                   `json:"name"`
+	Online        bool                     `json:"online"`
+	Storage       repository.Storage       `json:"storage"`
+	Proxy         repository.Proxy         `json:"proxy"`
+	NegativeCache repository.NegativeCache `json:"negativeCache"`
+	HTTPClient    repository.HTTPClient    `json:"httpClient"`
+	Docker        docker                   `json:"docker"`
+	DockerProxy   dockerProxy              `json:"dockerProxy"`
+
+	// RoutingRule is used in POST Call and GET call returns RoutingRuleName.
+	RoutingRule     *string `json:"routingRule,omitempty"`
+	RoutingRuleName *string `json:"routingRuleName,omitempty"`
+
+	Cleanup *repository.Cleanup `json:"cleanup,omitempty"`
+}
+
+type dockerGroupRepository struct {
+	Name    string                 `json:"name"`
+	Online  bool                   `json:"online"`
+	Group   repository.GroupDeploy `json:"group"`
+	Storage repository.Storage     `json:"storage"`
+	Docker  docker                 `json:"docker"`
+}
+
+type docker struct {
+	repository.Docker
+	// Pro-only: Allows to use repository name as subdomain
+	Subdomain *string `json:"subdomain,omitempty"`
+}
+
+type dockerProxy struct {
+	repository.DockerProxy
+	// Allow Nexus Repository Manager to download and cache foreign layers
+	CacheForeignLayers *bool `json:"cacheForeignLayers,omitempty"`
+	// Regular expressions used to identify URLs that are allowed for foreign layer requests
+	ForeignLayerURLWhitelist []string `json:"foreignLayerUrlWhitelist,omitempty"`
+}
+
+type DockerModel struct {
+	ForceBasicAuth types.Bool   `tfsdk:"force_basic_auth"`
+	HttpPort       types.Int64  `tfsdk:"http_port"`
+	HttpsPort      types.Int64  `tfsdk:"https_port"`
+	Subdomain      types.String `tfsdk:"subdomain"`
+	V1Enabled      types.Bool   `tfsdk:"v1_enabled"`
+}
+
+type DockerProxyModel struct {
+	IndexType                types.String   `tfsdk:"index_type"`
+	IndexUrl                 types.String   `tfsdk:"index_url"`
+	CacheForeignLayers       types.Bool     `tfsdk:"cache_foreign_layers"`
+	ForeignLayerUrlWhitelist []types.String `tfsdk:"foreign_layer_url_whitelist"`
+}
+
+func dockerResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Docker specific configuration of the repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"force_basic_auth": schema.BoolAttribute{
+				Description: "Whether to force authentication (Docker Bearer Token Realm required if false)",
+				Required:    true,
+			},
+			"http_port": schema.Int64Attribute{
+				Description: "Create an HTTP connector at specified port. Planning fails when another docker repository of the configuration or in nexus uses the port",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"https_port": schema.Int64Attribute{
+				Description: "Create an HTTPS connector at specified port. Planning fails when another docker repository of the configuration or in nexus uses the port",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"subdomain": schema.StringAttribute{
+				Description: "Pro-only: Whether to allow clients to use subdomain routing connector",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"v1_enabled": schema.BoolAttribute{
+				Description: "Whether to allow clients to use the V1 API to interact with this repository",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func dockerDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Docker specific configuration of the repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"force_basic_auth": dschema.BoolAttribute{
+				Description: "Whether to force authentication (Docker Bearer Token Realm required if false)",
+				Computed:    true,
+			},
+			"http_port": dschema.Int64Attribute{
+				Description: "The HTTP connector port",
+				Computed:    true,
+			},
+			"https_port": dschema.Int64Attribute{
+				Description: "The HTTPS connector port",
+				Computed:    true,
+			},
+			"subdomain": dschema.StringAttribute{
+				Description: "The subdomain of the subdomain routing connector",
+				Computed:    true,
+			},
+			"v1_enabled": dschema.BoolAttribute{
+				Description: "Whether to allow clients to use the V1 API to interact with this repository",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dockerProxyResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Configuration for docker proxy repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"index_type": schema.StringAttribute{
+				MarkdownDescription: "Type of Docker Index. Possible values: `HUB`, `REGISTRY` or `CUSTOM`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.DockerProxyIndexTypeHub),
+						string(repository.DockerProxyIndexTypeRegistry),
+						string(repository.DockerProxyIndexTypeCustom),
+					),
+				},
+			},
+			"index_url": schema.StringAttribute{
+				MarkdownDescription: "Url of Docker Index to use. Required if `index_type` is `CUSTOM`",
+				Optional:            true,
+			},
+			"cache_foreign_layers": schema.BoolAttribute{
+				MarkdownDescription: "Allow Nexus Repository Manager to download and cache foreign layers. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"foreign_layer_url_whitelist": schema.ListAttribute{
+				Description: "Regular expressions used to identify URLs that are allowed for foreign layer requests",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func dockerProxyDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Configuration for docker proxy repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"index_type": dschema.StringAttribute{
+				Description: "Type of Docker Index",
+				Computed:    true,
+			},
+			"index_url": dschema.StringAttribute{
+				Description: "Url of Docker Index to use",
+				Computed:    true,
+			},
+			"cache_foreign_layers": dschema.BoolAttribute{
+				Description: "Allow Nexus Repository Manager to download and cache foreign layers",
+				Computed:    true,
+			},
+			"foreign_layer_url_whitelist": dschema.ListAttribute{
+				Description: "Regular expressions used to identify URLs that are allowed for foreign layer requests",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func expandDocker(m *DockerModel) docker {
+	if m == nil {
+		return docker{}
+	}
+	d := docker{
+		Docker: repository.Docker{
+			ForceBasicAuth: m.ForceBasicAuth.ValueBool(),
+			V1Enabled:      m.V1Enabled.ValueBool(),
+		},
+		Subdomain: m.Subdomain.ValueStringPointer(),
+	}
+	if !m.HttpPort.IsNull() {
+		port := int(m.HttpPort.ValueInt64())
+		d.HTTPPort = &port
+	}
+	if !m.HttpsPort.IsNull() {
+		port := int(m.HttpsPort.ValueInt64())
+		d.HTTPSPort = &port
+	}
+	return d
+}
+
+func flattenDocker(d docker) *DockerModel {
+	return &DockerModel{
+		ForceBasicAuth: types.BoolValue(d.ForceBasicAuth),
+		HttpPort:       types.Int64PointerValue(intPointerToInt64(d.HTTPPort)),
+		HttpsPort:      types.Int64PointerValue(intPointerToInt64(d.HTTPSPort)),
+		Subdomain:      tfutil.StringValueOrNull(GetValue(d.Subdomain)),
+		V1Enabled:      types.BoolValue(d.V1Enabled),
+	}
+}
+
+func expandDockerProxy(m *DockerProxyModel) dockerProxy {
+	if m == nil {
+		return dockerProxy{}
+	}
+	d := dockerProxy{
+		DockerProxy: repository.DockerProxy{
+			IndexType: repository.DockerProxyIndexType(m.IndexType.ValueString()),
+			IndexURL:  m.IndexUrl.ValueStringPointer(),
+		},
+		CacheForeignLayers: m.CacheForeignLayers.ValueBoolPointer(),
+	}
+	for _, url := range m.ForeignLayerUrlWhitelist {
+		d.ForeignLayerURLWhitelist = append(d.ForeignLayerURLWhitelist, url.ValueString())
+	}
+	return d
+}
+
+func flattenDockerProxy(d dockerProxy) *DockerProxyModel {
+	dp := &DockerProxyModel{
+		IndexType:          types.StringValue(string(d.IndexType)),
+		IndexUrl:           tfutil.StringValueOrNull(GetValue(d.IndexURL)),
+		CacheForeignLayers: types.BoolValue(GetValue(d.CacheForeignLayers)),
+	}
+	for _, url := range d.ForeignLayerURLWhitelist {
+		dp.ForeignLayerUrlWhitelist = append(dp.ForeignLayerUrlWhitelist, types.StringValue(url))
+	}
+	return dp
+}
+
+// checkDockerPorts reports connector ports of the docker repository name that
+// are used twice by it, or by another docker repository in nexus. Unknown
+// ports are skipped. The ports of replaced, the repository in nexus which the
+// plan updates or replaces, are not reported.
+//
+// Nexus rejects a port while another repository uses it, so two repositories
+// can neither swap their ports nor hand one over in the same apply. Ports
+// which two repositories of one configuration take are reported by
+// claimDockerPorts.
+func checkDockerPorts(client *nexusclient.Client, name string, replaced string, m *DockerModel) (diags diag.Diagnostics) {
+	if client == nil || m == nil {
+		return
+	}
+	ports := []struct {
+		attr string
+		port types.Int64
+	}{{"http_port", m.HttpPort}, {"https_port", m.HttpsPort}}
+	if !m.HttpPort.IsNull() && !m.HttpPort.IsUnknown() && m.HttpPort.Equal(m.HttpsPort) {
+		diags.AddAttributeError(
+			path.Root("docker").AtName("https_port"),
+			"Docker port conflict",
+			fmt.Sprintf("http_port and https_port of docker repository %q are both %d.", name, m.HttpPort.ValueInt64()),
+		)
+		return
+	}
+
+	repos, err := listRepositorySettings(client)
+	if err != nil {
+		diags.AddError("Could not check the docker ports", err.Error())
+		return
+	}
+	for _, p := range ports {
+		attr, port := p.attr, p.port
+		if port.IsNull() || port.IsUnknown() {
+			continue
+		}
+		for _, repo := range repos {
+			if repo.Name == name || repo.Name == replaced || repo.Docker == nil {
+				continue
+			}
+			for _, used := range []*int{repo.Docker.HTTPPort, repo.Docker.HTTPSPort} {
+				if used == nil || int64(*used) != port.ValueInt64() {
+					continue
+				}
+				diags.AddAttributeError(
+					path.Root("docker").AtName(attr),
+					"Docker port conflict",
+					fmt.Sprintf("Port %d of docker repository %q is already used by docker repository %q in nexus. "+
+						"Nexus rejects a port while another repository uses it, move %q to another port in an earlier apply.",
+						port.ValueInt64(), name, repo.Name, repo.Name),
+				)
+			}
+		}
+	}
+	return
+}
+
+// claimDockerPorts records the known connector ports of the docker repository
+// name on the client when it is planned, and reports a port which another
+// repository of the same plan has claimed. It does not matter which of the two
+// repositories is planned second, the plan fails before anything is created.
+func claimDockerPorts(client *nexusclient.Client, name string, m *DockerModel) (diags diag.Diagnostics) {
+	if client == nil || m == nil {
+		return
+	}
+	var ports []int64
+	for _, port := range []types.Int64{m.HttpPort, m.HttpsPort} {
+		if !port.IsNull() && !port.IsUnknown() {
+			ports = append(ports, port.ValueInt64())
+		}
+	}
+	if port, owner := client.Ports.Claim(name, ports); owner != "" {
+		diags.AddAttributeError(
+			path.Root("docker"),
+			"Docker port conflict",
+			fmt.Sprintf("Port %d of docker repository %q is already used by docker repository %q of this configuration.", port, name, owner),
+		)
+	}
+	return
+}
@@ -0,0 +1,370 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// The schemas and conversions in this file are shared by the group
+// repositories of all formats.
+
+type GroupModel struct {
+	MemberNames []types.String `tfsdk:"member_names"`
+}
+
+// GroupDeployModel is the GroupModel of the formats which support the
+// deployment to a writable member of the group.
+type GroupDeployModel struct {
+	MemberNames    []types.String `tfsdk:"member_names"`
+	WritableMember types.String   `tfsdk:"writable_member"`
+}
+
+func groupResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Configuration for repository group",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"member_names": schema.ListAttribute{
+				Description: "Member repositories names, in the order they are searched",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func groupDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Configuration for repository group",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"member_names": dschema.ListAttribute{
+				Description: "Member repositories names, in the order they are searched",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func groupDeployResourceSchema() schema.SingleNestedAttribute {
+	group := groupResourceSchema()
+	group.Attributes["writable_member"] = schema.StringAttribute{
+		Description: "Pro-only: This field is for the Group Deployment feature, it names the member repository uploads to the group are stored in",
+		Optional:    true,
+	}
+	return group
+}
+
+func groupDeployDataSourceSchema() dschema.SingleNestedAttribute {
+	group := groupDataSourceSchema()
+	group.Attributes["writable_member"] = dschema.StringAttribute{
+		Description: "The member repository uploads to the group are stored in",
+		Computed:    true,
+	}
+	return group
+}
+
+func expandGroup(m *GroupModel) repository.Group {
+	group := repository.Group{MemberNames: []string{}}
+	if m == nil {
+		return group
+	}
+	for _, name := range m.MemberNames {
+		group.MemberNames = append(group.MemberNames, name.ValueString())
+	}
+	return group
+}
+
+func flattenGroup(g repository.Group) *GroupModel {
+	group := &GroupModel{MemberNames: []types.String{}}
+	for _, name := range g.MemberNames {
+		group.MemberNames = append(group.MemberNames, types.StringValue(name))
+	}
+	return group
+}
+
+func expandGroupDeploy(m *GroupDeployModel) repository.GroupDeploy {
+	if m == nil {
+		return repository.GroupDeploy{MemberNames: []string{}}
+	}
+	return repository.GroupDeploy{
+		MemberNames:    expandGroup(&GroupModel{MemberNames: m.MemberNames}).MemberNames,
+		WritableMember: m.WritableMember.ValueStringPointer(),
+	}
+}
+
+func flattenGroupDeploy(g repository.GroupDeploy) *GroupDeployModel {
+	group := &GroupDeployModel{
+		MemberNames:    flattenGroup(repository.Group{MemberNames: g.MemberNames}).MemberNames,
+		WritableMember: types.StringNull(),
+	}
+	if g.WritableMember != nil && *g.WritableMember != "" {
+		group.WritableMember = types.StringValue(*g.WritableMember)
+	}
+	return group
+}
+
+// RepositoryGroupModel holds the attributes shared by the group repositories
+// of all formats, the models of the formats embed it. The group attribute is
+// left to the formats since npm groups also have a writable member.
+type RepositoryGroupModel struct {
+	Id      types.String            `tfsdk:"id"`
+	Name    types.String            `tfsdk:"name"`
+	Online  types.Bool              `tfsdk:"online"`
+	Storage *StorageDataSourceModel `tfsdk:"storage"`
+}
+
+func (m RepositoryGroupModel) groupModel() RepositoryGroupModel {
+	return m
+}
+
+// groupRepositoryModel is the model of the group repositories of a format.
+type groupRepositoryModel interface {
+	groupModel() RepositoryGroupModel
+}
+
+// groupRepositoryResource is the resource of the group repositories of a
+// format, R is its go-nexus-client schema and M its model.
+type groupRepositoryResource[R any, M groupRepositoryModel] struct {
+	client *nexusclient.Client
+	// format is the format in the type name and the messages, e.g. "pypi".
+	format string
+	// attributes are the format specific attributes, in addition to the
+	// attributes of RepositoryGroupModel.
+	attributes map[string]schema.Attribute
+	// service returns the api of the group repositories of the format.
+	service func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[R]
+	expand  func(m M) R
+	// flatten converts the repository read from nexus, prior is the plan or
+	// the previous state.
+	flatten func(repo R, prior M) M
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &groupRepositoryResource[repository.PypiGroupRepository, RepositoryGroupResourceModel]{}
+	_ resource.ResourceWithImportState = &groupRepositoryResource[repository.PypiGroupRepository, RepositoryGroupResourceModel]{}
+)
+
+func (r *groupRepositoryResource[R, M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_" + r.format + "_group"
+}
+
+func (r *groupRepositoryResource[R, M]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Used to identify resource at nexus",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+			Required:    true,
+			Validators:  tfutil.NameValidators(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"storage": proxyStorageResourceSchema(),
+	}
+	for name, attribute := range r.attributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a group " + r.format + " repository.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *groupRepositoryResource[R, M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *groupRepositoryResource[R, M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create "+r.format+" group repository resource")
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.service(r.client).Create(r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating "+r.format+" group repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.groupModel().Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupRepositoryResource[R, M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.groupModel().Id.ValueString()
+	exists, err := repositoryExists(r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" group repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, r.format+" group repository not found, removing it from the state", map[string]interface{}{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(id, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a "+r.format+" group repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *groupRepositoryResource[R, M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.groupModel().Id.ValueString()
+	err := r.service(r.client).Update(id, r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating "+r.format+" group repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a "+r.format+" group repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *groupRepositoryResource[R, M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.service(r.client).Delete(state.groupModel().Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting "+r.format+" group repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *groupRepositoryResource[R, M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state.
+func (r *groupRepositoryResource[R, M]) getState(name string, prior M) (data M, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	repo, err := r.service(r.client).Get(name)
+	if err != nil {
+		return
+	}
+	data = r.flatten(*repo, prior)
+	return
+}
+
+// groupRepository is the go-nexus-client schema of the group repositories
+// without format specific settings and group deployment. The schemas of these
+// formats only differ in their name, so they convert into each other.
+type groupRepository interface {
+	repository.MavenGroupRepository | repository.NugetGroupRepository | repository.PypiGroupRepository | repository.RubyGemsGroupRepository
+}
+
+type RepositoryGroupResourceModel struct {
+	RepositoryGroupModel
+	Group *GroupModel `tfsdk:"group"`
+}
+
+func expandGroupRepository[R groupRepository](m RepositoryGroupResourceModel) R {
+	return R(repository.PypiGroupRepository{
+		Name:    m.Name.ValueString(),
+		Online:  m.Online.ValueBool(),
+		Group:   expandGroup(m.Group),
+		Storage: expandProxyStorage(m.Storage),
+	})
+}
+
+func flattenGroupRepository[R groupRepository](got R, prior RepositoryGroupResourceModel) RepositoryGroupResourceModel {
+	repo := repository.PypiGroupRepository(got)
+	return RepositoryGroupResourceModel{
+		RepositoryGroupModel: RepositoryGroupModel{
+			Id:      types.StringValue(repo.Name),
+			Name:    types.StringValue(repo.Name),
+			Online:  types.BoolValue(repo.Online),
+			Storage: flattenProxyStorage(repo.Storage),
+		},
+		Group: flattenGroup(repo.Group),
+	}
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// The schemas and conversions in this file are shared by the hosted
+// repositories of all formats.
+
+func hostedStorageResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The storage configuration of the repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"blob_store_name": schema.StringAttribute{
+				Description: "Blob store used to store repository contents",
+				Required:    true,
+			},
+			"strict_content_type_validation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to validate uploaded content's MIME type appropriate for the repository format. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"write_policy": schema.StringAttribute{
+				MarkdownDescription: "Controls if deployments of and updates to assets are allowed. Possible values: `ALLOW`, `ALLOW_ONCE` or `DENY`. Defaults to `ALLOW_ONCE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(repository.StorageWritePolicyAllowOnce)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.StorageWritePolicyAllow),
+						string(repository.StorageWritePolicyAllowOnce),
+						string(repository.StorageWritePolicyAllowDeny),
+					),
+				},
+			},
+		},
+	}
+}
+
+func hostedStorageDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "The storage configuration of the repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"blob_store_name": dschema.StringAttribute{
+				Description: "Blob store used to store repository contents",
+				Computed:    true,
+			},
+			"strict_content_type_validation": dschema.BoolAttribute{
+				Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+				Computed:    true,
+			},
+			"write_policy": dschema.StringAttribute{
+				Description: "Controls if deployments of and updates to assets are allowed",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandHostedStorage(m *StorageModel) repository.HostedStorage {
+	if m == nil {
+		return repository.HostedStorage{}
+	}
+	storage := repository.HostedStorage{
+		BlobStoreName:               m.BlobStoreName.ValueString(),
+		StrictContentTypeValidation: m.StrictContentTypeValidation.ValueBool(),
+	}
+	if !m.WritePolicy.IsNull() {
+		writePolicy := repository.StorageWritePolicy(m.WritePolicy.ValueString())
+		storage.WritePolicy = &writePolicy
+	}
+	return storage
+}
+
+func flattenHostedStorage(s repository.HostedStorage) *StorageModel {
+	storage := &StorageModel{
+		BlobStoreName:               types.StringValue(s.BlobStoreName),
+		StrictContentTypeValidation: types.BoolValue(s.StrictContentTypeValidation),
+		WritePolicy:                 types.StringNull(),
+	}
+	if s.WritePolicy != nil {
+		storage.WritePolicy = types.StringValue(string(*s.WritePolicy))
+	}
+	return storage
+}
+
+func componentResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Component configuration for the hosted repository",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"proprietary_components": schema.BoolAttribute{
+				Description: "Components in this repository count as proprietary for namespace conflict attacks (requires Sonatype Nexus Firewall)",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func componentDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Component configuration for the hosted repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"proprietary_components": dschema.BoolAttribute{
+				Description: "Components in this repository count as proprietary for namespace conflict attacks (requires Sonatype Nexus Firewall)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandComponent(m *ComponentModel) *repository.Component {
+	if m == nil {
+		return nil
+	}
+	return &repository.Component{ProprietaryComponents: m.ProprietaryComponents.ValueBool()}
+}
+
+// flattenComponent leaves out the default component settings nexus returns
+// for repositories created without them, unless prior has a component block.
+func flattenComponent(c *repository.Component, prior *ComponentModel) *ComponentModel {
+	if c == nil || (!c.ProprietaryComponents && prior == nil) {
+		return nil
+	}
+	return &ComponentModel{ProprietaryComponents: types.BoolValue(c.ProprietaryComponents)}
+}
+
+// RepositoryHostedModel holds the attributes shared by the hosted
+// repositories of all formats, the models of the formats embed it.
+type RepositoryHostedModel struct {
+	Id        types.String    `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Online    types.Bool      `tfsdk:"online"`
+	Cleanup   *CleanupModel   `tfsdk:"cleanup"`
+	Component *ComponentModel `tfsdk:"component"`
+	Storage   *StorageModel   `tfsdk:"storage"`
+}
+
+func (m RepositoryHostedModel) hostedModel() RepositoryHostedModel {
+	return m
+}
+
+// hostedRepositoryModel is the model of the hosted repositories of a format.
+type hostedRepositoryModel interface {
+	hostedModel() RepositoryHostedModel
+}
+
+// hostedRepositoryResource is the resource of the hosted repositories of a
+// format, R is its go-nexus-client schema and M its model.
+type hostedRepositoryResource[R any, M hostedRepositoryModel] struct {
+	client *nexusclient.Client
+	// format is the format in the type name and the messages, e.g. "npm".
+	format string
+	// attributes are the format specific attributes, in addition to the
+	// attributes of RepositoryHostedModel.
+	attributes map[string]schema.Attribute
+	// service returns the api of the hosted repositories of the format.
+	service func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[R]
+	expand  func(m M) R
+	// flatten converts the repository read from nexus, prior is the plan or
+	// the previous state.
+	flatten func(repo R, prior M) M
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &hostedRepositoryResource[repository.NpmHostedRepository, RepositoryHostedModel]{}
+	_ resource.ResourceWithImportState = &hostedRepositoryResource[repository.NpmHostedRepository, RepositoryHostedModel]{}
+)
+
+func (r *hostedRepositoryResource[R, M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_" + r.format + "_hosted"
+}
+
+func (r *hostedRepositoryResource[R, M]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Used to identify resource at nexus",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+			Required:    true,
+			Validators:  tfutil.NameValidators(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"cleanup":   cleanupResourceSchema(),
+		"component": componentResourceSchema(),
+		"storage":   hostedStorageResourceSchema(),
+	}
+	for name, attribute := range r.attributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a hosted " + r.format + " repository.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *hostedRepositoryResource[R, M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *hostedRepositoryResource[R, M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create "+r.format+" hosted repository resource")
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.service(r.client).Create(r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating "+r.format+" hosted repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.hostedModel().Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *hostedRepositoryResource[R, M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.hostedModel().Id.ValueString()
+	exists, err := repositoryExists(r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" hosted repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, r.format+" hosted repository not found, removing it from the state", map[string]interface{}{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(id, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a "+r.format+" hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *hostedRepositoryResource[R, M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.hostedModel().Id.ValueString()
+	err := r.service(r.client).Update(id, r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating "+r.format+" hosted repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a "+r.format+" hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *hostedRepositoryResource[R, M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.service(r.client).Delete(state.hostedModel().Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting "+r.format+" hosted repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *hostedRepositoryResource[R, M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state.
+func (r *hostedRepositoryResource[R, M]) getState(name string, prior M) (data M, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	repo, err := r.service(r.client).Get(name)
+	if err != nil {
+		return
+	}
+	data = r.flatten(*repo, prior)
+	return
+}
+
+// hostedRepository is the go-nexus-client schema of the hosted repositories
+// without format specific settings. The schemas of these formats only differ
+// in their name, so they convert into each other.
+type hostedRepository interface {
+	repository.NpmHostedRepository | repository.NugetHostedRepository | repository.PypiHostedRepository | repository.RubyGemsHostedRepository
+}
+
+func expandHostedRepository[R hostedRepository](m RepositoryHostedModel) R {
+	return R(repository.NpmHostedRepository{
+		Name:      m.Name.ValueString(),
+		Online:    m.Online.ValueBool(),
+		Storage:   expandHostedStorage(m.Storage),
+		Cleanup:   expandCleanup(m.Cleanup),
+		Component: expandComponent(m.Component),
+	})
+}
+
+func flattenHostedRepository[R hostedRepository](got R, prior RepositoryHostedModel) RepositoryHostedModel {
+	repo := repository.NpmHostedRepository(got)
+	return RepositoryHostedModel{
+		Id:        types.StringValue(repo.Name),
+		Name:      types.StringValue(repo.Name),
+		Online:    types.BoolValue(repo.Online),
+		Cleanup:   flattenCleanup(repo.Cleanup, prior.Cleanup),
+		Component: flattenComponent(repo.Component, prior.Component),
+		Storage:   flattenHostedStorage(repo.Storage),
+	}
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+// The schema and conversions in this file are shared by the maven hosted and
+// proxy repositories.
+
+type MavenModel struct {
+	VersionPolicy      types.String `tfsdk:"version_policy"`
+	LayoutPolicy       types.String `tfsdk:"layout_policy"`
+	ContentDisposition types.String `tfsdk:"content_disposition"`
+}
+
+func mavenResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Maven specific configuration of the repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"version_policy": schema.StringAttribute{
+				MarkdownDescription: "What type of artifacts does this repository store. Possible values: `RELEASE`, `SNAPSHOT` or `MIXED`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.MavenVersionPolicyRelease),
+						string(repository.MavenVersionPolicySnapshot),
+						string(repository.MavenVersionPolicyMixed),
+					),
+				},
+			},
+			"layout_policy": schema.StringAttribute{
+				MarkdownDescription: "Validate that all paths are maven artifact or metadata paths. Possible values: `STRICT` or `PERMISSIVE`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.MavenLayoutPolicyStrict),
+						string(repository.MavenLayoutPolicyPermissive),
+					),
+				},
+			},
+			"content_disposition": schema.StringAttribute{
+				MarkdownDescription: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser. " +
+					"Possible values: `INLINE` or `ATTACHMENT`. Nexus picks the default when unset.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.MavenContentDispositionInline),
+						string(repository.MavenContentDispositionAttachment),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func mavenDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Maven specific configuration of the repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"version_policy": dschema.StringAttribute{
+				Description: "What type of artifacts does this repository store",
+				Computed:    true,
+			},
+			"layout_policy": dschema.StringAttribute{
+				Description: "Validate that all paths are maven artifact or metadata paths",
+				Computed:    true,
+			},
+			"content_disposition": dschema.StringAttribute{
+				Description: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandMaven(m *MavenModel) repository.Maven {
+	if m == nil {
+		return repository.Maven{}
+	}
+	maven := repository.Maven{
+		VersionPolicy: repository.MavenVersionPolicy(m.VersionPolicy.ValueString()),
+		LayoutPolicy:  repository.MavenLayoutPolicy(m.LayoutPolicy.ValueString()),
+	}
+	if !m.ContentDisposition.IsNull() && !m.ContentDisposition.IsUnknown() {
+		contentDisposition := repository.MavenContentDisposition(m.ContentDisposition.ValueString())
+		maven.ContentDisposition = &contentDisposition
+	}
+	return maven
+}
+
+func flattenMaven(m repository.Maven) *MavenModel {
+	maven := &MavenModel{
+		VersionPolicy:      types.StringValue(string(m.VersionPolicy)),
+		LayoutPolicy:       types.StringValue(string(m.LayoutPolicy)),
+		ContentDisposition: types.StringNull(),
+	}
+	if m.ContentDisposition != nil {
+		maven.ContentDisposition = types.StringValue(string(*m.ContentDisposition))
+	}
+	return maven
+}
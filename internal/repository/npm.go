@@ -0,0 +1,74 @@
+package repository
+
+import (
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+type NpmModel struct {
+	RemoveNonCataloged types.Bool `tfsdk:"remove_non_cataloged"`
+	RemoveQuarantined  types.Bool `tfsdk:"remove_quarantined"`
+}
+
+func npmResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Npm specific configuration of the proxy repository",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"remove_non_cataloged": schema.BoolAttribute{
+				MarkdownDescription: "Remove non-catalogued versions from the npm package metadata. Defaults to `false`. (Requires Sonatype Nexus Firewall)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"remove_quarantined": schema.BoolAttribute{
+				MarkdownDescription: "Remove quarantined versions from the npm package metadata. Defaults to `false`. (Requires Sonatype Nexus Firewall)",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func npmDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Npm specific configuration of the proxy repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"remove_non_cataloged": dschema.BoolAttribute{
+				Description: "Remove non-catalogued versions from the npm package metadata",
+				Computed:    true,
+			},
+			"remove_quarantined": dschema.BoolAttribute{
+				Description: "Remove quarantined versions from the npm package metadata",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandNpm(m *NpmModel) *repository.Npm {
+	if m == nil {
+		return nil
+	}
+	return &repository.Npm{
+		RemoveNonCataloged: m.RemoveNonCataloged.ValueBool(),
+		RemoveQuarantined:  m.RemoveQuarantined.ValueBool(),
+	}
+}
+
+// flattenNpm leaves out the default npm settings nexus returns for
+// repositories created without them, unless prior has a npm block.
+func flattenNpm(n *repository.Npm, prior *NpmModel) *NpmModel {
+	if n == nil || (!n.RemoveNonCataloged && !n.RemoveQuarantined && prior == nil) {
+		return nil
+	}
+	return &NpmModel{
+		RemoveNonCataloged: types.BoolValue(n.RemoveNonCataloged),
+		RemoveQuarantined:  types.BoolValue(n.RemoveQuarantined),
+	}
+}
@@ -0,0 +1,772 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// The schemas and conversions in this file are shared by the proxy
+// repositories of all formats.
+
+func proxyResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Configuration for the proxy repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"remote_url": schema.StringAttribute{
+				Description: "Location of the remote repository being proxied",
+				Required:    true,
+			},
+			"content_max_age": schema.Int64Attribute{
+				MarkdownDescription: "How long (in minutes) to cache artifacts before rechecking the remote repository. Defaults to `1440`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1440),
+				Validators:          []validator.Int64{int64validator.AtLeast(-1)},
+			},
+			"metadata_max_age": schema.Int64Attribute{
+				MarkdownDescription: "How long (in minutes) to cache metadata before rechecking the remote repository. Defaults to `1440`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1440),
+				Validators:          []validator.Int64{int64validator.AtLeast(-1)},
+			},
+		},
+	}
+}
+
+func proxyDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Configuration for the proxy repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"remote_url": dschema.StringAttribute{
+				Description: "Location of the remote repository being proxied",
+				Computed:    true,
+			},
+			"content_max_age": dschema.Int64Attribute{
+				Description: "How long (in minutes) to cache artifacts before rechecking the remote repository",
+				Computed:    true,
+			},
+			"metadata_max_age": dschema.Int64Attribute{
+				Description: "How long (in minutes) to cache metadata before rechecking the remote repository",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandProxy(m *ProxyModel) repository.Proxy {
+	if m == nil {
+		return repository.Proxy{}
+	}
+	return repository.Proxy{
+		ContentMaxAge:  int(m.ContentMaxAge.ValueInt64()),
+		MetadataMaxAge: int(m.MetadataMaxAge.ValueInt64()),
+		RemoteURL:      m.RemoteURL.ValueString(),
+	}
+}
+
+func flattenProxy(p repository.Proxy) *ProxyModel {
+	return &ProxyModel{
+		ContentMaxAge:  types.Int64Value(int64(p.ContentMaxAge)),
+		MetadataMaxAge: types.Int64Value(int64(p.MetadataMaxAge)),
+		RemoteURL:      types.StringValue(p.RemoteURL),
+	}
+}
+
+func negativeCacheResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Configuration of the negative cache handling",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether to cache responses for content not present in the proxied repository",
+				Required:    true,
+			},
+			"ttl": schema.Int64Attribute{
+				Description: "How long to cache the fact that a file was not found in the repository (in minutes)",
+				Required:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	}
+}
+
+func negativeCacheDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Configuration of the negative cache handling",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"enabled": dschema.BoolAttribute{
+				Description: "Whether to cache responses for content not present in the proxied repository",
+				Computed:    true,
+			},
+			"ttl": dschema.Int64Attribute{
+				Description: "How long to cache the fact that a file was not found in the repository (in minutes)",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandNegativeCache(m *NegativeCacheModel) repository.NegativeCache {
+	if m == nil {
+		return repository.NegativeCache{}
+	}
+	return repository.NegativeCache{
+		Enabled: m.Enabled.ValueBool(),
+		TTL:     int(m.TTL.ValueInt64()),
+	}
+}
+
+func flattenNegativeCache(c repository.NegativeCache) *NegativeCacheModel {
+	return &NegativeCacheModel{
+		Enabled: types.BoolValue(c.Enabled),
+		TTL:     types.Int64Value(int64(c.TTL)),
+	}
+}
+
+func httpClientResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "HTTP Client configuration for proxy repositories",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"auto_block": schema.BoolAttribute{
+				Description: "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
+				Required:    true,
+			},
+			"blocked": schema.BoolAttribute{
+				Description: "Whether to block outbound connections on the repository",
+				Required:    true,
+			},
+			"authentication": schema.SingleNestedAttribute{
+				Description: "Authentication configuration of the HTTP client",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Authentication type. Possible values: `ntlm` or `username`",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(repository.HTTPClientAuthenticationTypeUsername),
+								string(repository.HTTPClientAuthenticationTypeNtlm),
+							),
+						},
+					},
+					"username": schema.StringAttribute{
+						Description: "The username used by the proxy repository",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password used by the proxy repository. " +
+							"This value cannot be read from the nexus api, so external changes won't be detected.",
+						Optional:  true,
+						Sensitive: true,
+					},
+					"ntlm_domain": schema.StringAttribute{
+						Description: "The ntlm domain to connect",
+						Optional:    true,
+					},
+					"ntlm_host": schema.StringAttribute{
+						Description: "The ntlm host to connect",
+						Optional:    true,
+					},
+				},
+			},
+			"connection": schema.SingleNestedAttribute{
+				Description: "Connection configuration of the HTTP client",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"enable_circular_redirects": schema.BoolAttribute{
+						MarkdownDescription: "Whether to enable redirects to the same location (may be required by some servers). Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"enable_cookies": schema.BoolAttribute{
+						MarkdownDescription: "Whether to allow cookies to be stored and used. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"retries": schema.Int64Attribute{
+						Description: "Total retries if the initial connection attempt suffers a timeout",
+						Optional:    true,
+						Validators:  []validator.Int64{int64validator.Between(0, 10)},
+					},
+					"timeout": schema.Int64Attribute{
+						Description: "Seconds to wait for activity before stopping and retrying the connection",
+						Optional:    true,
+						Validators:  []validator.Int64{int64validator.Between(1, 3600)},
+					},
+					"use_trust_store": schema.BoolAttribute{
+						MarkdownDescription: "Use certificates stored in the Nexus Repository Manager truststore to connect to external systems. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"user_agent_suffix": schema.StringAttribute{
+						Description: "Custom fragment to append to User-Agent header in HTTP requests",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// httpClientWithPreemptiveAuthResourceSchema adds the preemptive setting to
+// the authentication of httpClientResourceSchema, for the formats which support it.
+func httpClientWithPreemptiveAuthResourceSchema() schema.SingleNestedAttribute {
+	httpClient := httpClientResourceSchema()
+	auth := httpClient.Attributes["authentication"].(schema.SingleNestedAttribute)
+	auth.Attributes["preemptive"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to use pre-emptive authentication. Use with caution. Defaults to `false`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	httpClient.Attributes["authentication"] = auth
+	return httpClient
+}
+
+func httpClientDataSourceSchema(preemptive bool) dschema.SingleNestedAttribute {
+	authentication := map[string]dschema.Attribute{
+		"type": dschema.StringAttribute{
+			MarkdownDescription: "Authentication type. Possible values: `ntlm` or `username`",
+			Computed:            true,
+		},
+		"username": dschema.StringAttribute{
+			Description: "The username used by the proxy repository",
+			Computed:    true,
+		},
+		"password": dschema.StringAttribute{
+			Description: "The password used by the proxy repository. It is never read from the nexus api and always null.",
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"ntlm_domain": dschema.StringAttribute{
+			Description: "The ntlm domain to connect",
+			Computed:    true,
+		},
+		"ntlm_host": dschema.StringAttribute{
+			Description: "The ntlm host to connect",
+			Computed:    true,
+		},
+	}
+	if preemptive {
+		authentication["preemptive"] = dschema.BoolAttribute{
+			Description: "Whether to use pre-emptive authentication",
+			Computed:    true,
+		}
+	}
+	return dschema.SingleNestedAttribute{
+		Description: "HTTP Client configuration for proxy repositories",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"auto_block": dschema.BoolAttribute{
+				Description: "Whether to auto-block outbound connections if remote peer is detected as unreachable/unresponsive",
+				Computed:    true,
+			},
+			"blocked": dschema.BoolAttribute{
+				Description: "Whether to block outbound connections on the repository",
+				Computed:    true,
+			},
+			"authentication": dschema.SingleNestedAttribute{
+				Description: "Authentication configuration of the HTTP client",
+				Computed:    true,
+				Attributes:  authentication,
+			},
+			"connection": dschema.SingleNestedAttribute{
+				Description: "Connection configuration of the HTTP client",
+				Computed:    true,
+				Attributes: map[string]dschema.Attribute{
+					"enable_circular_redirects": dschema.BoolAttribute{
+						Description: "Whether to enable redirects to the same location (may be required by some servers)",
+						Computed:    true,
+					},
+					"enable_cookies": dschema.BoolAttribute{
+						Description: "Whether to allow cookies to be stored and used",
+						Computed:    true,
+					},
+					"retries": dschema.Int64Attribute{
+						Description: "Total retries if the initial connection attempt suffers a timeout",
+						Computed:    true,
+					},
+					"timeout": dschema.Int64Attribute{
+						Description: "Seconds to wait for activity before stopping and retrying the connection",
+						Computed:    true,
+					},
+					"use_trust_store": dschema.BoolAttribute{
+						Description: "Use certificates stored in the Nexus Repository Manager truststore to connect to external systems",
+						Computed:    true,
+					},
+					"user_agent_suffix": dschema.StringAttribute{
+						Description: "Custom fragment to append to User-Agent header in HTTP requests",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandHTTPClient(m *HttpClientModel) repository.HTTPClient {
+	if m == nil {
+		return repository.HTTPClient{}
+	}
+	httpClient := repository.HTTPClient{
+		AutoBlock: m.AutoBlock.ValueBool(),
+		Blocked:   m.Blocked.ValueBool(),
+	}
+	if auth := m.Authentication; auth != nil {
+		httpClient.Authentication = &repository.HTTPClientAuthentication{
+			NTLMDomain: auth.NtlmDomain.ValueString(),
+			NTLMHost:   auth.NtlmHost.ValueString(),
+			Password:   auth.Password.ValueString(),
+			Type:       repository.HTTPClientAuthenticationType(auth.Type.ValueString()),
+			Username:   auth.Username.ValueString(),
+		}
+	}
+	if conn := m.Connection; conn != nil {
+		httpClient.Connection = &repository.HTTPClientConnection{
+			EnableCircularRedirects: conn.EnableCircularRedirects.ValueBoolPointer(),
+			EnableCookies:           conn.EnableCookies.ValueBoolPointer(),
+			UseTrustStore:           conn.UseTrustStore.ValueBoolPointer(),
+			UserAgentSuffix:         conn.UserAgentSuffix.ValueString(),
+		}
+		if !conn.Retries.IsNull() {
+			retries := int(conn.Retries.ValueInt64())
+			httpClient.Connection.Retries = &retries
+		}
+		if !conn.Timeout.IsNull() {
+			timeout := int(conn.Timeout.ValueInt64())
+			httpClient.Connection.Timeout = &timeout
+		}
+	}
+	return httpClient
+}
+
+// flattenHTTPClient converts the http client settings read from nexus. The
+// password is write-only and is taken from prior, the plan or the previous
+// state, see keepWriteOnly. Data sources pass a nil prior. Nexus returns
+// default connection settings for repositories created without them, those
+// are left out unless prior has a connection block.
+func flattenHTTPClient(c repository.HTTPClient, prior *HttpClientModel) *HttpClientModel {
+	httpClient := &HttpClientModel{
+		AutoBlock: types.BoolValue(c.AutoBlock),
+		Blocked:   types.BoolValue(c.Blocked),
+	}
+	if auth := c.Authentication; auth != nil {
+		httpClient.Authentication = &HttpClientAuthenticationModel{
+			NtlmDomain: tfutil.StringValueOrNull(auth.NTLMDomain),
+			NtlmHost:   tfutil.StringValueOrNull(auth.NTLMHost),
+			Password:   types.StringNull(),
+			Type:       types.StringValue(string(auth.Type)),
+			Username:   tfutil.StringValueOrNull(auth.Username),
+		}
+		if prior != nil && prior.Authentication != nil {
+			httpClient.Authentication.Password = keepWriteOnly(prior.Authentication.Password)
+		}
+	}
+	if conn := c.Connection; conn != nil && (!isDefaultConnection(conn) || (prior != nil && prior.Connection != nil)) {
+		httpClient.Connection = &HttpClientConnectionModel{
+			EnableCircularRedirects: types.BoolValue(GetValue(conn.EnableCircularRedirects)),
+			EnableCookies:           types.BoolValue(GetValue(conn.EnableCookies)),
+			Retries:                 types.Int64PointerValue(intPointerToInt64(conn.Retries)),
+			Timeout:                 types.Int64PointerValue(intPointerToInt64(conn.Timeout)),
+			UseTrustStore:           types.BoolValue(GetValue(conn.UseTrustStore)),
+			UserAgentSuffix:         tfutil.StringValueOrNull(conn.UserAgentSuffix),
+		}
+	}
+	return httpClient
+}
+
+func isDefaultConnection(conn *repository.HTTPClientConnection) bool {
+	return !GetValue(conn.EnableCircularRedirects) && !GetValue(conn.EnableCookies) && !GetValue(conn.UseTrustStore) &&
+		conn.Retries == nil && conn.Timeout == nil && conn.UserAgentSuffix == ""
+}
+
+func intPointerToInt64(i *int) *int64 {
+	if i == nil {
+		return nil
+	}
+	v := int64(*i)
+	return &v
+}
+
+// HttpClientWithPreemptiveAuthModel is the HttpClientModel of the formats
+// whose authentication supports preemptive.
+type HttpClientWithPreemptiveAuthModel struct {
+	Authentication *HttpClientAuthenticationWithPreemptiveModel `tfsdk:"authentication"`
+	AutoBlock      types.Bool                                   `tfsdk:"auto_block"`
+	Blocked        types.Bool                                   `tfsdk:"blocked"`
+	Connection     *HttpClientConnectionModel                   `tfsdk:"connection"`
+}
+
+type HttpClientAuthenticationWithPreemptiveModel struct {
+	NtlmDomain types.String `tfsdk:"ntlm_domain"`
+	NtlmHost   types.String `tfsdk:"ntlm_host"`
+	Password   types.String `tfsdk:"password"`
+	Type       types.String `tfsdk:"type"`
+	Username   types.String `tfsdk:"username"`
+	Preemptive types.Bool   `tfsdk:"preemptive"`
+}
+
+// withoutPreemptive returns the settings in the shape of HttpClientModel, to
+// share the conversions of the formats without preemptive.
+func (m *HttpClientWithPreemptiveAuthModel) withoutPreemptive() *HttpClientModel {
+	if m == nil {
+		return nil
+	}
+	httpClient := &HttpClientModel{
+		AutoBlock:  m.AutoBlock,
+		Blocked:    m.Blocked,
+		Connection: m.Connection,
+	}
+	if auth := m.Authentication; auth != nil {
+		httpClient.Authentication = &HttpClientAuthenticationModel{
+			NtlmDomain: auth.NtlmDomain,
+			NtlmHost:   auth.NtlmHost,
+			Password:   auth.Password,
+			Type:       auth.Type,
+			Username:   auth.Username,
+		}
+	}
+	return httpClient
+}
+
+func expandHTTPClientWithPreemptiveAuth(m *HttpClientWithPreemptiveAuthModel) repository.HTTPClientWithPreemptiveAuth {
+	c := expandHTTPClient(m.withoutPreemptive())
+	httpClient := repository.HTTPClientWithPreemptiveAuth{
+		AutoBlock:  c.AutoBlock,
+		Blocked:    c.Blocked,
+		Connection: c.Connection,
+	}
+	if auth := c.Authentication; auth != nil {
+		httpClient.Authentication = &repository.HTTPClientAuthenticationWithPreemptive{
+			NTLMDomain: auth.NTLMDomain,
+			NTLMHost:   auth.NTLMHost,
+			Password:   auth.Password,
+			Type:       auth.Type,
+			Username:   auth.Username,
+			Preemptive: m.Authentication.Preemptive.ValueBoolPointer(),
+		}
+	}
+	return httpClient
+}
+
+func flattenHTTPClientWithPreemptiveAuth(c repository.HTTPClientWithPreemptiveAuth, prior *HttpClientWithPreemptiveAuthModel) *HttpClientWithPreemptiveAuthModel {
+	withoutPreemptive := repository.HTTPClient{
+		AutoBlock:  c.AutoBlock,
+		Blocked:    c.Blocked,
+		Connection: c.Connection,
+	}
+	if auth := c.Authentication; auth != nil {
+		withoutPreemptive.Authentication = &repository.HTTPClientAuthentication{
+			NTLMDomain: auth.NTLMDomain,
+			NTLMHost:   auth.NTLMHost,
+			Type:       auth.Type,
+			Username:   auth.Username,
+		}
+	}
+	flat := flattenHTTPClient(withoutPreemptive, prior.withoutPreemptive())
+	httpClient := &HttpClientWithPreemptiveAuthModel{
+		AutoBlock:  flat.AutoBlock,
+		Blocked:    flat.Blocked,
+		Connection: flat.Connection,
+	}
+	if auth := flat.Authentication; auth != nil {
+		httpClient.Authentication = &HttpClientAuthenticationWithPreemptiveModel{
+			NtlmDomain: auth.NtlmDomain,
+			NtlmHost:   auth.NtlmHost,
+			Password:   auth.Password,
+			Type:       auth.Type,
+			Username:   auth.Username,
+			Preemptive: types.BoolValue(GetValue(c.Authentication.Preemptive)),
+		}
+	}
+	return httpClient
+}
+
+// RepositoryProxyModel holds the attributes shared by the proxy repositories
+// of all formats, the models of the formats embed it.
+type RepositoryProxyModel struct {
+	Id            types.String            `tfsdk:"id"`
+	Name          types.String            `tfsdk:"name"`
+	Online        types.Bool              `tfsdk:"online"`
+	RoutingRule   types.String            `tfsdk:"routing_rule"`
+	Cleanup       *CleanupModel           `tfsdk:"cleanup"`
+	Storage       *StorageDataSourceModel `tfsdk:"storage"`
+	Proxy         *ProxyModel             `tfsdk:"proxy"`
+	NegativeCache *NegativeCacheModel     `tfsdk:"negative_cache"`
+}
+
+func (m RepositoryProxyModel) proxyModel() RepositoryProxyModel {
+	return m
+}
+
+// proxyRepositoryModel is the model of the proxy repositories of a format.
+type proxyRepositoryModel interface {
+	proxyModel() RepositoryProxyModel
+}
+
+// proxyRepositoryResource is the resource of the proxy repositories of a
+// format, R is its go-nexus-client schema and M its model.
+type proxyRepositoryResource[R any, M proxyRepositoryModel] struct {
+	client *nexusclient.Client
+	// format is the format in the type name and the messages, e.g. "npm".
+	format string
+	// attributes are the format specific attributes, in addition to the
+	// attributes of RepositoryProxyModel.
+	attributes map[string]schema.Attribute
+	// service returns the api of the proxy repositories of the format.
+	service func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[R]
+	expand  func(m M) R
+	// flatten converts the repository read from nexus, prior is the plan or
+	// the previous state, it provides the write-only authentication password.
+	flatten func(repo R, prior M) M
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &proxyRepositoryResource[repository.PypiProxyRepository, RepositoryProxyResourceModel]{}
+	_ resource.ResourceWithImportState = &proxyRepositoryResource[repository.PypiProxyRepository, RepositoryProxyResourceModel]{}
+)
+
+func (r *proxyRepositoryResource[R, M]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_" + r.format + "_proxy"
+}
+
+func (r *proxyRepositoryResource[R, M]) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Used to identify resource at nexus",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+			Required:    true,
+			Validators:  tfutil.NameValidators(),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"online": schema.BoolAttribute{
+			MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"routing_rule": schema.StringAttribute{
+			Description: "The name of the routing rule assigned to this repository",
+			Optional:    true,
+		},
+		"cleanup":        cleanupResourceSchema(),
+		"storage":        proxyStorageResourceSchema(),
+		"proxy":          proxyResourceSchema(),
+		"negative_cache": negativeCacheResourceSchema(),
+	}
+	for name, attribute := range r.attributes {
+		attributes[name] = attribute
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a proxy " + r.format + " repository.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *proxyRepositoryResource[R, M]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *proxyRepositoryResource[R, M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create "+r.format+" proxy repository resource")
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.service(r.client).Create(r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating "+r.format+" proxy repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.proxyModel().Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *proxyRepositoryResource[R, M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.proxyModel().Id.ValueString()
+	exists, err := repositoryExists(r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" proxy repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, r.format+" proxy repository not found, removing it from the state", map[string]interface{}{"id": id})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(id, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a "+r.format+" proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *proxyRepositoryResource[R, M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan M
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.proxyModel().Id.ValueString()
+	err := r.service(r.client).Update(id, r.expand(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating "+r.format+" proxy repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(id, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get "+r.format+" proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a "+r.format+" proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *proxyRepositoryResource[R, M]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state M
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.service(r.client).Delete(state.proxyModel().Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting "+r.format+" proxy repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *proxyRepositoryResource[R, M]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state.
+func (r *proxyRepositoryResource[R, M]) getState(name string, prior M) (data M, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	repo, err := r.service(r.client).Get(name)
+	if err != nil {
+		return
+	}
+	data = r.flatten(*repo, prior)
+	return
+}
+
+// proxyRepository is the go-nexus-client schema of the proxy repositories
+// without format specific settings. The schemas of these formats only differ
+// in their name, so they convert into each other.
+type proxyRepository interface {
+	repository.PypiProxyRepository | repository.RubyGemsProxyRepository
+}
+
+type RepositoryProxyResourceModel struct {
+	RepositoryProxyModel
+	HttpClient *HttpClientModel `tfsdk:"http_client"`
+}
+
+func expandProxyRepository[R proxyRepository](m RepositoryProxyResourceModel) R {
+	return R(repository.PypiProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		RoutingRule:   m.RoutingRule.ValueStringPointer(),
+		Cleanup:       expandCleanup(m.Cleanup),
+	})
+}
+
+func flattenProxyRepository[R proxyRepository](got R, prior RepositoryProxyResourceModel) RepositoryProxyResourceModel {
+	repo := repository.PypiProxyRepository(got)
+	return RepositoryProxyResourceModel{
+		RepositoryProxyModel: RepositoryProxyModel{
+			Id:            types.StringValue(repo.Name),
+			Name:          types.StringValue(repo.Name),
+			Online:        types.BoolValue(repo.Online),
+			RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+			Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Storage:       flattenProxyStorage(repo.Storage),
+			Proxy:         flattenProxy(repo.Proxy),
+			NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		},
+		HttpClient: flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+}
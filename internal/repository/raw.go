@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+)
+
+// The schema and conversions in this file are shared by the raw hosted, proxy
+// and group repositories.
+
+type RawModel struct {
+	ContentDisposition types.String `tfsdk:"content_disposition"`
+}
+
+func rawResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Raw specific configuration of the repository",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"content_disposition": schema.StringAttribute{
+				MarkdownDescription: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser. " +
+					"Possible values: `INLINE` or `ATTACHMENT`. Nexus uses `ATTACHMENT` when unset.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.RawContentDispositionInline),
+						string(repository.RawContentDispositionAttachment),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func rawDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Raw specific configuration of the repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"content_disposition": dschema.StringAttribute{
+				Description: "Add Content-Disposition header as 'Attachment' to disable some content from being inline in a browser",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandRaw(m *RawModel) *repository.Raw {
+	if m == nil || m.ContentDisposition.IsNull() || m.ContentDisposition.IsUnknown() {
+		return nil
+	}
+	contentDisposition := repository.RawContentDisposition(m.ContentDisposition.ValueString())
+	return &repository.Raw{ContentDisposition: &contentDisposition}
+}
+
+// flattenRaw leaves out the default content disposition nexus returns for
+// repositories created without raw settings, unless prior has a raw block.
+func flattenRaw(r *repository.Raw, prior *RawModel) *RawModel {
+	if r == nil || r.ContentDisposition == nil {
+		if prior == nil {
+			return nil
+		}
+		return &RawModel{ContentDisposition: types.StringNull()}
+	}
+	if prior == nil && *r.ContentDisposition == repository.RawContentDispositionAttachment {
+		return nil
+	}
+	return &RawModel{ContentDisposition: types.StringValue(string(*r.ContentDisposition))}
+}
@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceRepositoryAptHosted defines the resource implementation.
+type ResourceRepositoryAptHosted struct {
+	client *nexusclient.Client
+}
+
+type RepositoryAptHostedResourceModel struct {
+	Id           types.String     `tfsdk:"id"`
+	Name         types.String     `tfsdk:"name"`
+	Online       types.Bool       `tfsdk:"online"`
+	Distribution types.String     `tfsdk:"distribution"`
+	Signing      *AptSigningModel `tfsdk:"signing"`
+	Cleanup      *CleanupModel    `tfsdk:"cleanup"`
+	Component    *ComponentModel  `tfsdk:"component"`
+	Storage      *StorageModel    `tfsdk:"storage"`
+}
+
+type AptSigningModel struct {
+	Keypair    types.String `tfsdk:"keypair"`
+	Passphrase types.String `tfsdk:"passphrase"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ResourceRepositoryAptHosted{}
+	_ resource.ResourceWithImportState = &ResourceRepositoryAptHosted{}
+)
+
+func NewResourceRepositoryAptHosted() resource.Resource {
+	return &ResourceRepositoryAptHosted{}
+}
+
+func (r *ResourceRepositoryAptHosted) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_apt_hosted"
+}
+
+func (r *ResourceRepositoryAptHosted) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a hosted apt repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"distribution": schema.StringAttribute{
+				Description: "Distribution to fetch",
+				Required:    true,
+			},
+			"signing": schema.SingleNestedAttribute{
+				Description: "Contains signing data of hosted repositories of format Apt. " +
+					"The keypair and passphrase are never read from the nexus api, so external changes won't be detected. " +
+					"After an import the first apply sets them again from the configuration.",
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"keypair": schema.StringAttribute{
+						Description: "PGP signing key pair (armored private key e.g. gpg --export-secret-key --armor)",
+						Required:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"passphrase": schema.StringAttribute{
+						Description: "Passphrase to access PGP signing key",
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"cleanup":   cleanupResourceSchema(),
+			"component": componentResourceSchema(),
+			"storage":   hostedStorageResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceRepositoryAptHosted) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ResourceRepositoryAptHosted) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create apt hosted repository resource")
+	var plan RepositoryAptHostedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Repository.Apt.Hosted.Create(plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating apt hosted repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryAptHosted) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryAptHostedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := repositoryExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt hosted repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "apt hosted repository not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read an apt hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceRepositoryAptHosted) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryAptHostedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Repository.Apt.Hosted.Update(plan.Id.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating apt hosted repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update an apt hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryAptHosted) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryAptHostedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Repository.Apt.Hosted.Delete(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting apt hosted repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceRepositoryAptHosted) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state, the
+// signing keypair and passphrase are always taken from it.
+func (r *ResourceRepositoryAptHosted) getState(name string, prior RepositoryAptHostedResourceModel) (data RepositoryAptHostedResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	repo, err := r.client.Repository.Apt.Hosted.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryAptHostedResourceModel{
+		Id:           types.StringValue(repo.Name),
+		Name:         types.StringValue(repo.Name),
+		Online:       types.BoolValue(repo.Online),
+		Distribution: types.StringValue(repo.Apt.Distribution),
+		Signing:      flattenAptSigning(prior.Signing),
+		Cleanup:      flattenCleanup(repo.Cleanup, prior.Cleanup),
+		Component:    flattenComponent(repo.Component, prior.Component),
+		Storage:      flattenHostedStorage(repo.Storage),
+	}
+	return
+}
+
+func flattenAptSigning(prior *AptSigningModel) *AptSigningModel {
+	if prior == nil {
+		return nil
+	}
+	return &AptSigningModel{
+		Keypair:    keepWriteOnly(prior.Keypair),
+		Passphrase: keepWriteOnly(prior.Passphrase),
+	}
+}
+
+func (m RepositoryAptHostedResourceModel) toRepository() repository.AptHostedRepository {
+	repo := repository.AptHostedRepository{
+		Name:    m.Name.ValueString(),
+		Online:  m.Online.ValueBool(),
+		Storage: expandHostedStorage(m.Storage),
+		Apt: repository.AptHosted{
+			Distribution: m.Distribution.ValueString(),
+		},
+		Cleanup:   expandCleanup(m.Cleanup),
+		Component: expandComponent(m.Component),
+	}
+	if m.Signing != nil {
+		repo.AptSigning = repository.AptSigning{
+			Keypair:    m.Signing.Keypair.ValueString(),
+			Passphrase: m.Signing.Passphrase.ValueStringPointer(),
+		}
+	}
+	return repo
+}
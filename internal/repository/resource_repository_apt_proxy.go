@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceRepositoryAptProxy defines the resource implementation.
+type ResourceRepositoryAptProxy struct {
+	client *nexusclient.Client
+}
+
+type RepositoryAptProxyResourceModel struct {
+	Id            types.String            `tfsdk:"id"`
+	Name          types.String            `tfsdk:"name"`
+	Online        types.Bool              `tfsdk:"online"`
+	Distribution  types.String            `tfsdk:"distribution"`
+	Flat          types.Bool              `tfsdk:"flat"`
+	RoutingRule   types.String            `tfsdk:"routing_rule"`
+	Cleanup       *CleanupModel           `tfsdk:"cleanup"`
+	Storage       *StorageDataSourceModel `tfsdk:"storage"`
+	Proxy         *ProxyModel             `tfsdk:"proxy"`
+	NegativeCache *NegativeCacheModel     `tfsdk:"negative_cache"`
+	HttpClient    *HttpClientModel        `tfsdk:"http_client"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ResourceRepositoryAptProxy{}
+	_ resource.ResourceWithImportState = &ResourceRepositoryAptProxy{}
+)
+
+func NewResourceRepositoryAptProxy() resource.Resource {
+	return &ResourceRepositoryAptProxy{}
+}
+
+func (r *ResourceRepositoryAptProxy) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_apt_proxy"
+}
+
+func (r *ResourceRepositoryAptProxy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a proxy apt repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"distribution": schema.StringAttribute{
+				Description: "Distribution to fetch",
+				Required:    true,
+			},
+			"flat": schema.BoolAttribute{
+				Description: "Whether this repository is flat",
+				Required:    true,
+			},
+			"routing_rule": schema.StringAttribute{
+				Description: "The name of the routing rule assigned to this repository",
+				Optional:    true,
+			},
+			"cleanup":        cleanupResourceSchema(),
+			"storage":        proxyStorageResourceSchema(),
+			"proxy":          proxyResourceSchema(),
+			"negative_cache": negativeCacheResourceSchema(),
+			"http_client":    httpClientResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceRepositoryAptProxy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *ResourceRepositoryAptProxy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create apt proxy repository resource")
+	var plan RepositoryAptProxyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Repository.Apt.Proxy.Create(plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating apt proxy repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryAptProxy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryAptProxyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := repositoryExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt proxy repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "apt proxy repository not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read an apt proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceRepositoryAptProxy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryAptProxyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Repository.Apt.Proxy.Update(plan.Id.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating apt proxy repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get apt proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update an apt proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryAptProxy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryAptProxyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Repository.Apt.Proxy.Delete(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting apt proxy repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceRepositoryAptProxy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state, it
+// provides the write-only authentication password.
+func (r *ResourceRepositoryAptProxy) getState(name string, prior RepositoryAptProxyResourceModel) (data RepositoryAptProxyResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	repo, err := r.client.Repository.Apt.Proxy.Get(name)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryAptProxyResourceModel{
+		Id:            types.StringValue(repo.Name),
+		Name:          types.StringValue(repo.Name),
+		Online:        types.BoolValue(repo.Online),
+		Distribution:  types.StringValue(repo.Apt.Distribution),
+		Flat:          types.BoolValue(repo.Apt.Flat),
+		RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+		Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+		Storage:       flattenProxyStorage(repo.Storage),
+		Proxy:         flattenProxy(repo.Proxy),
+		NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		HttpClient:    flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+	return
+}
+
+func (m RepositoryAptProxyResourceModel) toRepository() repository.AptProxyRepository {
+	return repository.AptProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		Apt: repository.AptProxy{
+			Distribution: m.Distribution.ValueString(),
+			Flat:         m.Flat.ValueBool(),
+		},
+		RoutingRule: m.RoutingRule.ValueStringPointer(),
+		Cleanup:     expandCleanup(m.Cleanup),
+	}
+}
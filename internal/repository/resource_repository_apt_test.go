@@ -0,0 +1,255 @@
+package repository_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryAptHosted(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(distribution string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_apt_hosted" "test" {
+  name   = "apt-hosted"
+  online = true
+
+  distribution = "` + distribution + `"
+
+  signing = {
+    keypair    = "signing-keypair"
+    passphrase = "signing-passphrase"
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW"
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("bullseye"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "distribution", "bullseye"),
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "signing.keypair", "signing-keypair"),
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "signing.passphrase", "signing-passphrase"),
+					acctest.CheckRepository(server, "apt-hosted", "apt.distribution", "bullseye"),
+					acctest.CheckRepository(server, "apt-hosted", "aptSigning.keypair", "signing-keypair"),
+					acctest.CheckRepository(server, "apt-hosted", "aptSigning.passphrase", "signing-passphrase"),
+				),
+			},
+			{
+				Config: config("bookworm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "distribution", "bookworm"),
+					acctest.CheckRepository(server, "apt-hosted", "apt.distribution", "bookworm"),
+				),
+			},
+			{
+				// Nexus never returns the signing keys, the refresh keeps the
+				// configured ones.
+				PreConfig: func() {
+					request(t, server, http.MethodPut, "v1/repositories/apt/hosted/apt-hosted", `{
+  "name": "apt-hosted",
+  "online": true,
+  "storage": {"blobStoreName": "repository-test", "strictContentTypeValidation": true, "writePolicy": "ALLOW"},
+  "apt": {"distribution": "bookworm"},
+  "aptSigning": {}
+}`)
+				},
+				Config: config("bookworm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "signing.keypair", "signing-keypair"),
+					resource.TestCheckResourceAttr("nexus_repository_apt_hosted.test", "signing.passphrase", "signing-passphrase"),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_apt_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "apt-hosted",
+				ImportStateVerify: true,
+				// Import leaves the signing keys unset, nexus never returns them.
+				ImportStateVerifyIgnore: []string{"signing.%", "signing.keypair", "signing.passphrase"},
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryAptProxy(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(distribution string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_apt_proxy" "test" {
+  name   = "apt-proxy"
+  online = true
+
+  distribution = "` + distribution + `"
+  flat         = false
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url       = "https://deb.debian.org/debian/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "mirror"
+      password = "mirror-password"
+    }
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("bullseye"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_proxy.test", "distribution", "bullseye"),
+					resource.TestCheckResourceAttr("nexus_repository_apt_proxy.test", "http_client.authentication.password", "mirror-password"),
+					acctest.CheckRepository(server, "apt-proxy", "apt.distribution", "bullseye"),
+					acctest.CheckRepository(server, "apt-proxy", "httpClient.authentication.username", "mirror"),
+					acctest.CheckRepository(server, "apt-proxy", "httpClient.authentication.password", "mirror-password"),
+				),
+			},
+			{
+				Config: config("bookworm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_proxy.test", "distribution", "bookworm"),
+					acctest.CheckRepository(server, "apt-proxy", "apt.distribution", "bookworm"),
+				),
+			},
+			{
+				// Nexus never returns the password, the refresh keeps the
+				// configured one.
+				PreConfig: func() {
+					request(t, server, http.MethodPut, "v1/repositories/apt/proxy/apt-proxy", `{
+  "name": "apt-proxy",
+  "online": true,
+  "storage": {"blobStoreName": "repository-test", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://deb.debian.org/debian/", "contentMaxAge": 1440, "metadataMaxAge": 1440},
+  "negativeCache": {"enabled": true, "timeToLive": 1440},
+  "httpClient": {"blocked": false, "autoBlock": true, "authentication": {"type": "username", "username": "mirror"}},
+  "apt": {"distribution": "bookworm", "flat": false}
+}`)
+				},
+				Config: config("bookworm"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_apt_proxy.test", "http_client.authentication.password", "mirror-password"),
+				),
+			},
+			{
+				ResourceName:            "nexus_repository_apt_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "apt-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryAptProxyPasswordFromNexus(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_apt_proxy" "test" {
+  name   = "apt-proxy"
+  online = true
+
+  distribution = "bookworm"
+  flat         = false
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url       = "https://deb.debian.org/debian/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "mirror"
+      password = "mirror-password"
+    }
+  }
+}
+
+data "nexus_repository_apt_proxy" "test" {
+  name = nexus_repository_apt_proxy.test.name
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				// The mock returns the password it was sent, unlike nexus.
+				PreConfig: func() {
+					request(t, server, http.MethodPut, "v1/repositories/apt/proxy/apt-proxy", `{
+  "name": "apt-proxy",
+  "online": true,
+  "storage": {"blobStoreName": "repository-test", "strictContentTypeValidation": true},
+  "proxy": {"remoteUrl": "https://deb.debian.org/debian/", "contentMaxAge": 1440, "metadataMaxAge": 1440},
+  "negativeCache": {"enabled": true, "timeToLive": 1440},
+  "httpClient": {"blocked": false, "autoBlock": true, "authentication": {"type": "username", "username": "mirror", "password": "nexus-password"}},
+  "apt": {"distribution": "bookworm", "flat": false}
+}`)
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckRepository(server, "apt-proxy", "httpClient.authentication.password", "nexus-password"),
+					resource.TestCheckResourceAttr("nexus_repository_apt_proxy.test", "http_client.authentication.password", "mirror-password"),
+					resource.TestCheckResourceAttr("data.nexus_repository_apt_proxy.test", "http_client.authentication.username", "mirror"),
+					resource.TestCheckNoResourceAttr("data.nexus_repository_apt_proxy.test", "http_client.authentication.password"),
+				),
+			},
+		},
+	})
+}
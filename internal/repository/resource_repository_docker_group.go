@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceRepositoryDockerGroup defines the resource implementation.
+type ResourceRepositoryDockerGroup struct {
+	client *nexusclient.Client
+}
+
+type RepositoryDockerGroupResourceModel struct {
+	Id      types.String            `tfsdk:"id"`
+	Name    types.String            `tfsdk:"name"`
+	Online  types.Bool              `tfsdk:"online"`
+	Docker  *DockerModel            `tfsdk:"docker"`
+	Group   *GroupDeployModel       `tfsdk:"group"`
+	Storage *StorageDataSourceModel `tfsdk:"storage"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ResourceRepositoryDockerGroup{}
+	_ resource.ResourceWithImportState = &ResourceRepositoryDockerGroup{}
+	_ resource.ResourceWithModifyPlan  = &ResourceRepositoryDockerGroup{}
+)
+
+func NewResourceRepositoryDockerGroup() resource.Resource {
+	return &ResourceRepositoryDockerGroup{}
+}
+
+func (r *ResourceRepositoryDockerGroup) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_docker_group"
+}
+
+func (r *ResourceRepositoryDockerGroup) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a group docker repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"docker":  dockerResourceSchema(),
+			"group":   groupDeployResourceSchema(),
+			"storage": proxyStorageResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceRepositoryDockerGroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan reports connector ports which are used by other docker
+// repositories, in nexus or in the same plan, see checkDockerPorts and
+// claimDockerPorts.
+func (r *ResourceRepositoryDockerGroup) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var name, priorName types.String
+	var docker *DockerModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("docker"), &docker)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+	}
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, name.ValueString(), priorName.ValueString(), docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(claimDockerPorts(r.client, name.ValueString(), docker)...)
+}
+
+func (r *ResourceRepositoryDockerGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create docker group repository resource")
+	var plan RepositoryDockerGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), "", plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := createRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeGroup, plan.Name.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating docker group repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerGroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryDockerGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := repositoryExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker group repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "docker group repository not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a docker group repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceRepositoryDockerGroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryDockerGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), plan.Id.ValueString(), plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeGroup, plan.Id.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating docker group repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker group repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a docker group repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerGroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryDockerGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Repository.Delete(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting docker group repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceRepositoryDockerGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state.
+func (r *ResourceRepositoryDockerGroup) getState(name string, prior RepositoryDockerGroupResourceModel) (data RepositoryDockerGroupResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	var repo dockerGroupRepository
+	err = getRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeGroup, name, &repo)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryDockerGroupResourceModel{
+		Id:      types.StringValue(repo.Name),
+		Name:    types.StringValue(repo.Name),
+		Online:  types.BoolValue(repo.Online),
+		Docker:  flattenDocker(repo.Docker),
+		Group:   flattenGroupDeploy(repo.Group),
+		Storage: flattenProxyStorage(repo.Storage),
+	}
+	return
+}
+
+func (m RepositoryDockerGroupResourceModel) toRepository() dockerGroupRepository {
+	return dockerGroupRepository{
+		Name:    m.Name.ValueString(),
+		Online:  m.Online.ValueBool(),
+		Docker:  expandDocker(m.Docker),
+		Group:   expandGroupDeploy(m.Group),
+		Storage: expandProxyStorage(m.Storage),
+	}
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceRepositoryDockerHosted defines the resource implementation.
+type ResourceRepositoryDockerHosted struct {
+	client *nexusclient.Client
+}
+
+type RepositoryDockerHostedResourceModel struct {
+	Id        types.String    `tfsdk:"id"`
+	Name      types.String    `tfsdk:"name"`
+	Online    types.Bool      `tfsdk:"online"`
+	Docker    *DockerModel    `tfsdk:"docker"`
+	Cleanup   *CleanupModel   `tfsdk:"cleanup"`
+	Component *ComponentModel `tfsdk:"component"`
+	Storage   *StorageModel   `tfsdk:"storage"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ResourceRepositoryDockerHosted{}
+	_ resource.ResourceWithImportState = &ResourceRepositoryDockerHosted{}
+	_ resource.ResourceWithModifyPlan  = &ResourceRepositoryDockerHosted{}
+)
+
+func NewResourceRepositoryDockerHosted() resource.Resource {
+	return &ResourceRepositoryDockerHosted{}
+}
+
+func (r *ResourceRepositoryDockerHosted) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_docker_hosted"
+}
+
+func (r *ResourceRepositoryDockerHosted) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a hosted docker repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"docker":    dockerResourceSchema(),
+			"cleanup":   cleanupResourceSchema(),
+			"component": componentResourceSchema(),
+			"storage":   hostedStorageResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceRepositoryDockerHosted) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan reports connector ports which are used by other docker
+// repositories, in nexus or in the same plan, see checkDockerPorts and
+// claimDockerPorts.
+func (r *ResourceRepositoryDockerHosted) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var name, priorName types.String
+	var docker *DockerModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("docker"), &docker)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+	}
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, name.ValueString(), priorName.ValueString(), docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(claimDockerPorts(r.client, name.ValueString(), docker)...)
+}
+
+func (r *ResourceRepositoryDockerHosted) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create docker hosted repository resource")
+	var plan RepositoryDockerHostedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), "", plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := createRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeHosted, plan.Name.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating docker hosted repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerHosted) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryDockerHostedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := repositoryExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker hosted repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "docker hosted repository not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a docker hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceRepositoryDockerHosted) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryDockerHostedResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), plan.Id.ValueString(), plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeHosted, plan.Id.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating docker hosted repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker hosted repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a docker hosted repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerHosted) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryDockerHostedResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Repository.Delete(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting docker hosted repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceRepositoryDockerHosted) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state.
+func (r *ResourceRepositoryDockerHosted) getState(name string, prior RepositoryDockerHostedResourceModel) (data RepositoryDockerHostedResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	var repo dockerHostedRepository
+	err = getRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeHosted, name, &repo)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryDockerHostedResourceModel{
+		Id:        types.StringValue(repo.Name),
+		Name:      types.StringValue(repo.Name),
+		Online:    types.BoolValue(repo.Online),
+		Docker:    flattenDocker(repo.Docker),
+		Cleanup:   flattenCleanup(repo.Cleanup, prior.Cleanup),
+		Component: flattenComponent(repo.Component, prior.Component),
+		Storage:   flattenHostedStorage(repo.Storage),
+	}
+	return
+}
+
+func (m RepositoryDockerHostedResourceModel) toRepository() dockerHostedRepository {
+	return dockerHostedRepository{
+		Name:      m.Name.ValueString(),
+		Online:    m.Online.ValueBool(),
+		Storage:   expandHostedStorage(m.Storage),
+		Docker:    expandDocker(m.Docker),
+		Cleanup:   expandCleanup(m.Cleanup),
+		Component: expandComponent(m.Component),
+	}
+}
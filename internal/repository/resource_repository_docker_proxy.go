@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+// ResourceRepositoryDockerProxy defines the resource implementation.
+type ResourceRepositoryDockerProxy struct {
+	client *nexusclient.Client
+}
+
+type RepositoryDockerProxyResourceModel struct {
+	Id            types.String            `tfsdk:"id"`
+	Name          types.String            `tfsdk:"name"`
+	Online        types.Bool              `tfsdk:"online"`
+	Docker        *DockerModel            `tfsdk:"docker"`
+	DockerProxy   *DockerProxyModel       `tfsdk:"docker_proxy"`
+	RoutingRule   types.String            `tfsdk:"routing_rule"`
+	Cleanup       *CleanupModel           `tfsdk:"cleanup"`
+	Storage       *StorageDataSourceModel `tfsdk:"storage"`
+	Proxy         *ProxyModel             `tfsdk:"proxy"`
+	NegativeCache *NegativeCacheModel     `tfsdk:"negative_cache"`
+	HttpClient    *HttpClientModel        `tfsdk:"http_client"`
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ResourceRepositoryDockerProxy{}
+	_ resource.ResourceWithImportState = &ResourceRepositoryDockerProxy{}
+	_ resource.ResourceWithModifyPlan  = &ResourceRepositoryDockerProxy{}
+)
+
+func NewResourceRepositoryDockerProxy() resource.Resource {
+	return &ResourceRepositoryDockerProxy{}
+}
+
+func (r *ResourceRepositoryDockerProxy) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_docker_proxy"
+}
+
+func (r *ResourceRepositoryDockerProxy) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create a proxy docker repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify resource at nexus",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "A unique identifier for this repository. Changing the name forces a new repository to be created.",
+				Required:    true,
+				Validators:  tfutil.NameValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether this repository accepts incoming requests. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"routing_rule": schema.StringAttribute{
+				Description: "The name of the routing rule assigned to this repository",
+				Optional:    true,
+			},
+			"docker":         dockerResourceSchema(),
+			"docker_proxy":   dockerProxyResourceSchema(),
+			"cleanup":        cleanupResourceSchema(),
+			"storage":        proxyStorageResourceSchema(),
+			"proxy":          proxyResourceSchema(),
+			"negative_cache": negativeCacheResourceSchema(),
+			"http_client":    httpClientResourceSchema(),
+		},
+	}
+}
+
+func (r *ResourceRepositoryDockerProxy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan reports connector ports which are used by other docker
+// repositories, in nexus or in the same plan, see checkDockerPorts and
+// claimDockerPorts.
+func (r *ResourceRepositoryDockerProxy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var name, priorName types.String
+	var docker *DockerModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("docker"), &docker)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &priorName)...)
+	}
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, name.ValueString(), priorName.ValueString(), docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(claimDockerPorts(r.client, name.ValueString(), docker)...)
+}
+
+func (r *ResourceRepositoryDockerProxy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create docker proxy repository resource")
+	var plan RepositoryDockerProxyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), "", plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := createRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy, plan.Name.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating docker proxy repository", "Could not create, unexpected error: "+err.Error())
+		return
+	}
+
+	state, err := r.getState(plan.Name.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "created a resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerProxy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryDockerProxyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	exists, err := repositoryExists(r.client, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker proxy repository from nexus failed", err.Error())
+		return
+	}
+	if !exists {
+		tflog.Debug(ctx, "docker proxy repository not found, removing it from the state", map[string]interface{}{"id": state.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, err := r.getState(state.Id.ValueString(), state)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "read a docker proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *ResourceRepositoryDockerProxy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryDockerProxyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkDockerPorts(r.client, plan.Name.ValueString(), plan.Id.ValueString(), plan.Docker)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy, plan.Id.ValueString(), plan.toRepository())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating docker proxy repository",
+			"Could not update, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state, err := r.getState(plan.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Get docker proxy repository from nexus failed", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "update a docker proxy repository resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ResourceRepositoryDockerProxy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryDockerProxyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.client.Repository.Delete(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting docker proxy repository",
+			"Could not delete, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ResourceRepositoryDockerProxy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getState reads the repository. prior is the plan or the previous state, it
+// provides the write-only authentication password.
+func (r *ResourceRepositoryDockerProxy) getState(name string, prior RepositoryDockerProxyResourceModel) (data RepositoryDockerProxyResourceModel, err error) {
+	if name == "" {
+		err = errors.New("name is nil")
+		return
+	}
+	var repo dockerProxyRepository
+	err = getRepository(r.client, repository.RepositoryFormatDocker, repository.RepositoryTypeProxy, name, &repo)
+	if err != nil {
+		return
+	}
+
+	data = RepositoryDockerProxyResourceModel{
+		Id:            types.StringValue(repo.Name),
+		Name:          types.StringValue(repo.Name),
+		Online:        types.BoolValue(repo.Online),
+		Docker:        flattenDocker(repo.Docker),
+		DockerProxy:   flattenDockerProxy(repo.DockerProxy),
+		RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+		Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+		Storage:       flattenProxyStorage(repo.Storage),
+		Proxy:         flattenProxy(repo.Proxy),
+		NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		HttpClient:    flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+	return
+}
+
+func (m RepositoryDockerProxyResourceModel) toRepository() dockerProxyRepository {
+	return dockerProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		Docker:        expandDocker(m.Docker),
+		DockerProxy:   expandDockerProxy(m.DockerProxy),
+		RoutingRule:   m.RoutingRule.ValueStringPointer(),
+		Cleanup:       expandCleanup(m.Cleanup),
+	}
+}
@@ -0,0 +1,287 @@
+package repository_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+// request sends a request to the nexus api of the mock server, to change it
+// outside of terraform.
+func request(t *testing.T, server *mocknexus.Server, method string, path string, body string) {
+	t.Helper()
+	req, err := http.NewRequest(method, server.URL+"/service/rest/"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.SetBasicAuth(server.Username, server.Password)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		t.Fatalf("%s %s: HTTP %d", method, path, resp.StatusCode)
+	}
+}
+
+// dockerHostedConfig returns a docker hosted repository with the connector
+// ports, "null" leaves a port unset.
+func dockerHostedConfig(resourceName string, name string, httpPort string, httpsPort string) string {
+	return fmt.Sprintf(`
+resource "nexus_repository_docker_hosted" %q {
+  name   = %q
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW"
+  }
+
+  docker = {
+    force_basic_auth = false
+    v1_enabled       = false
+    http_port        = %s
+    https_port       = %s
+  }
+}
+`, resourceName, name, httpPort, httpsPort)
+}
+
+func TestResourceRepositoryDocker(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(hostedName string) string {
+		return server.ProviderConfig() + blobstoreConfig + dockerHostedConfig("test", hostedName, "8080", "8443") + `
+resource "nexus_repository_docker_proxy" "test" {
+  name   = "docker-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  docker = {
+    force_basic_auth = true
+    v1_enabled       = false
+    http_port        = 8081
+  }
+
+  docker_proxy = {
+    index_type = "HUB"
+  }
+
+  proxy = {
+    remote_url       = "https://registry-1.docker.io"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+resource "nexus_repository_docker_group" "test" {
+  name   = "docker-group"
+  online = true
+
+  docker = {
+    force_basic_auth = true
+    v1_enabled       = false
+    http_port        = 8082
+  }
+
+  group = {
+    member_names = [
+      nexus_repository_docker_hosted.test.name,
+      nexus_repository_docker_proxy.test.name,
+    ]
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("docker-hosted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_docker_hosted.test", "docker.http_port", "8080"),
+					resource.TestCheckResourceAttr("nexus_repository_docker_proxy.test", "docker_proxy.cache_foreign_layers", "false"),
+					acctest.CheckRepository(server, "docker-hosted", "docker.httpsPort", "8443"),
+					acctest.CheckRepository(server, "docker-proxy", "docker.httpPort", "8081"),
+					acctest.CheckRepository(server, "docker-group", "group.memberNames", `["docker-hosted","docker-proxy"]`),
+				),
+			},
+			{
+				// Renaming replaces the repository, its ports are free again
+				// when the new one is created.
+				Config: config("docker-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_docker_hosted.test", "name", "docker-renamed"),
+					acctest.CheckRepository(server, "docker-renamed", "docker.httpPort", "8080"),
+					acctest.CheckRepository(server, "docker-group", "group.memberNames", `["docker-renamed","docker-proxy"]`),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_docker_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "docker-renamed",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "nexus_repository_docker_proxy.test",
+				ImportState:       true,
+				ImportStateId:     "docker-proxy",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "nexus_repository_docker_group.test",
+				ImportState:       true,
+				ImportStateId:     "docker-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// wrapped matches text in an error which terraform may wrap at any space.
+func wrapped(text string) string {
+	return strings.ReplaceAll(regexp.QuoteMeta(text), " ", `\s+`)
+}
+
+func TestResourceRepositoryDockerPortConflict(t *testing.T) {
+	server := acctest.NewServer(t)
+	other := `{"name":"docker-other","online":true,` +
+		`"storage":{"blobStoreName":"repository-test","strictContentTypeValidation":true,"writePolicy":"ALLOW"},` +
+		`"docker":{"forceBasicAuth":false,"v1Enabled":false,"httpPort":8093}}`
+	firstTakesSecond := wrapped(`Port 8091 of docker repository "docker-first" is already used by docker repository "docker-second" in nexus`)
+	secondTakesFirst := wrapped(`Port 8090 of docker repository "docker-second" is already used by docker repository "docker-first" in nexus`)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8090", "null") +
+					dockerHostedConfig("second", "docker-second", "8091", "null"),
+			},
+			{
+				// Nexus rejects a port in use, so two repositories cannot
+				// swap their ports in one apply. Both are reported, whatever
+				// the order they are planned in.
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8091", "null") +
+					dockerHostedConfig("second", "docker-second", "8090", "null"),
+				ExpectError: regexp.MustCompile(`(?s)(` + firstTakesSecond + `.*` + secondTakesFirst + `|` + secondTakesFirst + `.*` + firstTakesSecond + `)`),
+			},
+			{
+				// A repository of the configuration must not take the port
+				// of another one.
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8090", "null") +
+					dockerHostedConfig("second", "docker-second", "8090", "null"),
+				ExpectError: regexp.MustCompile(secondTakesFirst),
+			},
+			{
+				// A port is handed over in two applies, the repository which
+				// moves away goes first.
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8090", "null") +
+					dockerHostedConfig("second", "docker-second", "8092", "null"),
+				Check: acctest.CheckRepository(server, "docker-second", "docker.httpPort", "8092"),
+			},
+			{
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8091", "null") +
+					dockerHostedConfig("second", "docker-second", "8092", "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckRepository(server, "docker-first", "docker.httpPort", "8091"),
+					acctest.CheckRepository(server, "docker-second", "docker.httpPort", "8092"),
+				),
+			},
+			{
+				// Nor the port of a repository outside of terraform.
+				PreConfig: func() {
+					request(t, server, http.MethodPost, "v1/repositories/docker/hosted", other)
+				},
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8091", "null") +
+					dockerHostedConfig("second", "docker-second", "8092", "null") +
+					dockerHostedConfig("third", "docker-third", "8093", "null"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(wrapped(`already used by docker repository "docker-other" in nexus`)),
+			},
+			{
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8091", "8091"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(wrapped(`http_port and https_port of docker repository "docker-first" are both`)),
+			},
+			{
+				PreConfig: func() {
+					request(t, server, http.MethodDelete, "v1/repositories/docker-other", "")
+				},
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8091", "null") +
+					dockerHostedConfig("second", "docker-second", "8093", "null"),
+				Check: acctest.CheckRepository(server, "docker-second", "docker.httpPort", "8093"),
+			},
+		},
+	})
+}
+
+func TestResourceRepositoryDockerPortConflictInConfiguration(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				// Neither repository exists in nexus yet, the plan catches
+				// the conflict before anything is created.
+				Config: server.ProviderConfig() + blobstoreConfig +
+					dockerHostedConfig("first", "docker-first", "8090", "null") +
+					dockerHostedConfig("second", "docker-second", "null", "8090"),
+				ExpectError: regexp.MustCompile(`Port 8090 of docker repository "docker-(first|second)" is already used by\s+docker\s+repository\s+"docker-(first|second)"\s+of\s+this\s+configuration`),
+			},
+			{
+				Config: server.ProviderConfig(),
+				Check: func(*terraform.State) error {
+					for _, name := range []string{"docker-first", "docker-second"} {
+						if _, exists := server.Repository(name); exists {
+							return fmt.Errorf("repository %q was created", name)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
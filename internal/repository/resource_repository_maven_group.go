@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryMavenGroup() resource.Resource {
+	return &groupRepositoryResource[repository.MavenGroupRepository, RepositoryGroupResourceModel]{
+		format: "maven",
+		attributes: map[string]schema.Attribute{
+			"group": groupResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.MavenGroupRepository] {
+			return client.Repository.Maven.Group
+		},
+		expand:  expandGroupRepository[repository.MavenGroupRepository],
+		flatten: flattenGroupRepository[repository.MavenGroupRepository],
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryMavenHostedResourceModel struct {
+	RepositoryHostedModel
+	Maven *MavenModel `tfsdk:"maven"`
+}
+
+func NewResourceRepositoryMavenHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.MavenHostedRepository, RepositoryMavenHostedResourceModel]{
+		format: "maven",
+		attributes: map[string]schema.Attribute{
+			"maven": mavenResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.MavenHostedRepository] {
+			return client.Repository.Maven.Hosted
+		},
+		expand:  RepositoryMavenHostedResourceModel.toRepository,
+		flatten: flattenMavenHostedRepository,
+	}
+}
+
+func (m RepositoryMavenHostedResourceModel) toRepository() repository.MavenHostedRepository {
+	return repository.MavenHostedRepository{
+		Name:      m.Name.ValueString(),
+		Online:    m.Online.ValueBool(),
+		Storage:   expandHostedStorage(m.Storage),
+		Maven:     expandMaven(m.Maven),
+		Cleanup:   expandCleanup(m.Cleanup),
+		Component: expandComponent(m.Component),
+	}
+}
+
+func flattenMavenHostedRepository(repo repository.MavenHostedRepository, prior RepositoryMavenHostedResourceModel) RepositoryMavenHostedResourceModel {
+	return RepositoryMavenHostedResourceModel{
+		RepositoryHostedModel: RepositoryHostedModel{
+			Id:        types.StringValue(repo.Name),
+			Name:      types.StringValue(repo.Name),
+			Online:    types.BoolValue(repo.Online),
+			Cleanup:   flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Component: flattenComponent(repo.Component, prior.Component),
+			Storage:   flattenHostedStorage(repo.Storage),
+		},
+		Maven: flattenMaven(repo.Maven),
+	}
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryMavenProxyResourceModel struct {
+	RepositoryProxyModel
+	Maven      *MavenModel                        `tfsdk:"maven"`
+	HttpClient *HttpClientWithPreemptiveAuthModel `tfsdk:"http_client"`
+}
+
+func NewResourceRepositoryMavenProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.MavenProxyRepository, RepositoryMavenProxyResourceModel]{
+		format: "maven",
+		attributes: map[string]schema.Attribute{
+			"maven":       mavenResourceSchema(),
+			"http_client": httpClientWithPreemptiveAuthResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.MavenProxyRepository] {
+			return client.Repository.Maven.Proxy
+		},
+		expand:  RepositoryMavenProxyResourceModel.toRepository,
+		flatten: flattenMavenProxyRepository,
+	}
+}
+
+func (m RepositoryMavenProxyResourceModel) toRepository() repository.MavenProxyRepository {
+	return repository.MavenProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClientWithPreemptiveAuth(m.HttpClient),
+		Maven:         expandMaven(m.Maven),
+		RoutingRule:   m.RoutingRule.ValueStringPointer(),
+		Cleanup:       expandCleanup(m.Cleanup),
+	}
+}
+
+func flattenMavenProxyRepository(repo repository.MavenProxyRepository, prior RepositoryMavenProxyResourceModel) RepositoryMavenProxyResourceModel {
+	return RepositoryMavenProxyResourceModel{
+		RepositoryProxyModel: RepositoryProxyModel{
+			Id:            types.StringValue(repo.Name),
+			Name:          types.StringValue(repo.Name),
+			Online:        types.BoolValue(repo.Online),
+			RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+			Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Storage:       flattenProxyStorage(repo.Storage),
+			Proxy:         flattenProxy(repo.Proxy),
+			NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		},
+		Maven:      flattenMaven(repo.Maven),
+		HttpClient: flattenHTTPClientWithPreemptiveAuth(repo.HTTPClient, prior.HttpClient),
+	}
+}
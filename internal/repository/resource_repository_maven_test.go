@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryMaven(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(versionPolicy string, contentDisposition string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_maven_hosted" "test" {
+  name   = "maven-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+
+  maven = {
+    version_policy      = "` + versionPolicy + `"
+    layout_policy       = "STRICT"
+    content_disposition = "` + contentDisposition + `"
+  }
+}
+
+resource "nexus_repository_maven_proxy" "test" {
+  name   = "maven-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  maven = {
+    version_policy = "RELEASE"
+    layout_policy  = "PERMISSIVE"
+  }
+
+  proxy = {
+    remote_url       = "https://repo1.maven.org/maven2/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "maven"
+      password = "secret"
+    }
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("RELEASE", "INLINE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_maven_hosted.test", "maven.version_policy", "RELEASE"),
+					resource.TestCheckResourceAttr("nexus_repository_maven_proxy.test", "http_client.authentication.preemptive", "false"),
+					resource.TestCheckResourceAttr("nexus_repository_maven_proxy.test", "http_client.authentication.password", "secret"),
+					acctest.CheckRepository(server, "maven-hosted", "format", "maven2"),
+					acctest.CheckRepository(server, "maven-hosted", "maven.contentDisposition", "INLINE"),
+					acctest.CheckRepository(server, "maven-proxy", "httpClient.authentication.username", "maven"),
+				),
+			},
+			{
+				Config: config("SNAPSHOT", "ATTACHMENT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_maven_hosted.test", "maven.version_policy", "SNAPSHOT"),
+					acctest.CheckRepository(server, "maven-hosted", "maven.versionPolicy", "SNAPSHOT"),
+					acctest.CheckRepository(server, "maven-hosted", "maven.contentDisposition", "ATTACHMENT"),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_maven_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "maven-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "nexus_repository_maven_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "maven-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+		},
+	})
+}
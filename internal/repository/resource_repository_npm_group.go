@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryNpmGroupResourceModel struct {
+	RepositoryGroupModel
+	Group *GroupDeployModel `tfsdk:"group"`
+}
+
+func NewResourceRepositoryNpmGroup() resource.Resource {
+	return &groupRepositoryResource[repository.NpmGroupRepository, RepositoryNpmGroupResourceModel]{
+		format: "npm",
+		attributes: map[string]schema.Attribute{
+			"group": groupDeployResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NpmGroupRepository] {
+			return client.Repository.Npm.Group
+		},
+		expand:  RepositoryNpmGroupResourceModel.toRepository,
+		flatten: flattenNpmGroupRepository,
+	}
+}
+
+func (m RepositoryNpmGroupResourceModel) toRepository() repository.NpmGroupRepository {
+	return repository.NpmGroupRepository{
+		Name:    m.Name.ValueString(),
+		Online:  m.Online.ValueBool(),
+		Group:   expandGroupDeploy(m.Group),
+		Storage: expandProxyStorage(m.Storage),
+	}
+}
+
+func flattenNpmGroupRepository(repo repository.NpmGroupRepository, prior RepositoryNpmGroupResourceModel) RepositoryNpmGroupResourceModel {
+	return RepositoryNpmGroupResourceModel{
+		RepositoryGroupModel: RepositoryGroupModel{
+			Id:      types.StringValue(repo.Name),
+			Name:    types.StringValue(repo.Name),
+			Online:  types.BoolValue(repo.Online),
+			Storage: flattenProxyStorage(repo.Storage),
+		},
+		Group: flattenGroupDeploy(repo.Group),
+	}
+}
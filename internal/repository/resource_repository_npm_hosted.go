@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryNpmHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.NpmHostedRepository, RepositoryHostedModel]{
+		format: "npm",
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NpmHostedRepository] {
+			return client.Repository.Npm.Hosted
+		},
+		expand:  expandHostedRepository[repository.NpmHostedRepository],
+		flatten: flattenHostedRepository[repository.NpmHostedRepository],
+	}
+}
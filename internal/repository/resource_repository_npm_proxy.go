@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryNpmProxyResourceModel struct {
+	RepositoryProxyModel
+	Npm        *NpmModel        `tfsdk:"npm"`
+	HttpClient *HttpClientModel `tfsdk:"http_client"`
+}
+
+func NewResourceRepositoryNpmProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.NpmProxyRepository, RepositoryNpmProxyResourceModel]{
+		format: "npm",
+		attributes: map[string]schema.Attribute{
+			"npm":         npmResourceSchema(),
+			"http_client": httpClientResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NpmProxyRepository] {
+			return client.Repository.Npm.Proxy
+		},
+		expand:  RepositoryNpmProxyResourceModel.toRepository,
+		flatten: flattenNpmProxyRepository,
+	}
+}
+
+func (m RepositoryNpmProxyResourceModel) toRepository() repository.NpmProxyRepository {
+	return repository.NpmProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		Npm:           expandNpm(m.Npm),
+		RoutingRule:   m.RoutingRule.ValueStringPointer(),
+		Cleanup:       expandCleanup(m.Cleanup),
+	}
+}
+
+func flattenNpmProxyRepository(repo repository.NpmProxyRepository, prior RepositoryNpmProxyResourceModel) RepositoryNpmProxyResourceModel {
+	return RepositoryNpmProxyResourceModel{
+		RepositoryProxyModel: RepositoryProxyModel{
+			Id:            types.StringValue(repo.Name),
+			Name:          types.StringValue(repo.Name),
+			Online:        types.BoolValue(repo.Online),
+			RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+			Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Storage:       flattenProxyStorage(repo.Storage),
+			Proxy:         flattenProxy(repo.Proxy),
+			NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		},
+		Npm:        flattenNpm(repo.Npm, prior.Npm),
+		HttpClient: flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+}
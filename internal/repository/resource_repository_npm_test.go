@@ -0,0 +1,140 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryNpm(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(writePolicy string, removeQuarantined bool, writableMember string) string {
+		quarantined := "false"
+		if removeQuarantined {
+			quarantined = "true"
+		}
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_npm_hosted" "test" {
+  name   = "npm-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "` + writePolicy + `"
+  }
+
+  component = {
+    proprietary_components = true
+  }
+}
+
+resource "nexus_repository_npm_proxy" "test" {
+  name   = "npm-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  npm = {
+    remove_quarantined = ` + quarantined + `
+  }
+
+  proxy = {
+    remote_url       = "https://registry.npmjs.org/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "npm"
+      password = "secret"
+    }
+  }
+}
+
+resource "nexus_repository_npm_group" "test" {
+  name   = "npm-group"
+  online = true
+
+  group = {
+    member_names = [
+      nexus_repository_npm_hosted.test.name,
+      nexus_repository_npm_proxy.test.name,
+    ]
+    writable_member = ` + writableMember + `
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ALLOW_ONCE", false, "null"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_npm_hosted.test", "component.proprietary_components", "true"),
+					resource.TestCheckResourceAttr("nexus_repository_npm_proxy.test", "npm.remove_quarantined", "false"),
+					resource.TestCheckResourceAttr("nexus_repository_npm_proxy.test", "http_client.authentication.password", "secret"),
+					resource.TestCheckNoResourceAttr("nexus_repository_npm_group.test", "group.writable_member"),
+					acctest.CheckRepository(server, "npm-hosted", "format", "npm"),
+					acctest.CheckRepository(server, "npm-hosted", "storage.writePolicy", "ALLOW_ONCE"),
+					acctest.CheckRepository(server, "npm-hosted", "component.proprietaryComponents", "true"),
+					acctest.CheckRepository(server, "npm-proxy", "proxy.remoteUrl", "https://registry.npmjs.org/"),
+					acctest.CheckRepository(server, "npm-group", "group.memberNames", `["npm-hosted","npm-proxy"]`),
+				),
+			},
+			{
+				Config: config("ALLOW", true, "nexus_repository_npm_hosted.test.name"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_npm_hosted.test", "storage.write_policy", "ALLOW"),
+					resource.TestCheckResourceAttr("nexus_repository_npm_proxy.test", "npm.remove_quarantined", "true"),
+					resource.TestCheckResourceAttr("nexus_repository_npm_group.test", "group.writable_member", "npm-hosted"),
+					acctest.CheckRepository(server, "npm-hosted", "storage.writePolicy", "ALLOW"),
+					acctest.CheckRepository(server, "npm-proxy", "npm.removeQuarantined", "true"),
+					acctest.CheckRepository(server, "npm-group", "group.writableMember", "npm-hosted"),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_npm_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "npm-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "nexus_repository_npm_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "npm-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+			{
+				ResourceName:      "nexus_repository_npm_group.test",
+				ImportState:       true,
+				ImportStateId:     "npm-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryNugetGroup() resource.Resource {
+	return &groupRepositoryResource[repository.NugetGroupRepository, RepositoryGroupResourceModel]{
+		format: "nuget",
+		attributes: map[string]schema.Attribute{
+			"group": groupResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NugetGroupRepository] {
+			return client.Repository.Nuget.Group
+		},
+		expand:  expandGroupRepository[repository.NugetGroupRepository],
+		flatten: flattenGroupRepository[repository.NugetGroupRepository],
+	}
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryNugetHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.NugetHostedRepository, RepositoryHostedModel]{
+		format: "nuget",
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NugetHostedRepository] {
+			return client.Repository.Nuget.Hosted
+		},
+		expand:  expandHostedRepository[repository.NugetHostedRepository],
+		flatten: flattenHostedRepository[repository.NugetHostedRepository],
+	}
+}
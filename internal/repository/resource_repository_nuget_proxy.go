@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryNugetProxyResourceModel struct {
+	RepositoryProxyModel
+	NugetVersion         types.String     `tfsdk:"nuget_version"`
+	QueryCacheItemMaxAge types.Int64      `tfsdk:"query_cache_item_max_age"`
+	HttpClient           *HttpClientModel `tfsdk:"http_client"`
+}
+
+func NewResourceRepositoryNugetProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.NugetProxyRepository, RepositoryNugetProxyResourceModel]{
+		format: "nuget",
+		attributes: map[string]schema.Attribute{
+			"nuget_version": schema.StringAttribute{
+				MarkdownDescription: "Nuget protocol version. Possible values: `V2` or `V3`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(repository.NugetVersion2),
+						string(repository.NugetVersion3),
+					),
+				},
+			},
+			"query_cache_item_max_age": schema.Int64Attribute{
+				MarkdownDescription: "How long to cache query results from the proxied repository (in seconds). Defaults to `3600`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"http_client": httpClientResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.NugetProxyRepository] {
+			return client.Repository.Nuget.Proxy
+		},
+		expand:  RepositoryNugetProxyResourceModel.toRepository,
+		flatten: flattenNugetProxyRepository,
+	}
+}
+
+func (m RepositoryNugetProxyResourceModel) toRepository() repository.NugetProxyRepository {
+	return repository.NugetProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		NugetProxy: repository.NugetProxy{
+			NugetVersion:         repository.NugetVersion(m.NugetVersion.ValueString()),
+			QueryCacheItemMaxAge: int(m.QueryCacheItemMaxAge.ValueInt64()),
+		},
+		RoutingRule: m.RoutingRule.ValueStringPointer(),
+		Cleanup:     expandCleanup(m.Cleanup),
+	}
+}
+
+func flattenNugetProxyRepository(repo repository.NugetProxyRepository, prior RepositoryNugetProxyResourceModel) RepositoryNugetProxyResourceModel {
+	return RepositoryNugetProxyResourceModel{
+		RepositoryProxyModel: RepositoryProxyModel{
+			Id:            types.StringValue(repo.Name),
+			Name:          types.StringValue(repo.Name),
+			Online:        types.BoolValue(repo.Online),
+			RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+			Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Storage:       flattenProxyStorage(repo.Storage),
+			Proxy:         flattenProxy(repo.Proxy),
+			NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		},
+		NugetVersion:         types.StringValue(string(repo.NugetProxy.NugetVersion)),
+		QueryCacheItemMaxAge: types.Int64Value(int64(repo.NugetProxy.QueryCacheItemMaxAge)),
+		HttpClient:           flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+}
@@ -0,0 +1,128 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryNuget(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(writePolicy string, nugetVersion string, members string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_nuget_hosted" "test" {
+  name   = "nuget-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "` + writePolicy + `"
+  }
+}
+
+resource "nexus_repository_nuget_proxy" "test" {
+  name   = "nuget-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  nuget_version            = "` + nugetVersion + `"
+  query_cache_item_max_age = 1800
+
+  proxy = {
+    remote_url       = "https://api.nuget.org/v3/index.json"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "nuget"
+      password = "secret"
+    }
+  }
+}
+
+resource "nexus_repository_nuget_group" "test" {
+  name   = "nuget-group"
+  online = true
+
+  group = {
+    member_names = ` + members + `
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ALLOW_ONCE", "V3", "[nexus_repository_nuget_hosted.test.name, nexus_repository_nuget_proxy.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_nuget_hosted.test", "storage.write_policy", "ALLOW_ONCE"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_proxy.test", "nuget_version", "V3"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_proxy.test", "query_cache_item_max_age", "1800"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_proxy.test", "http_client.authentication.password", "secret"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_group.test", "group.member_names.#", "2"),
+					acctest.CheckRepository(server, "nuget-hosted", "format", "nuget"),
+					acctest.CheckRepository(server, "nuget-hosted", "storage.writePolicy", "ALLOW_ONCE"),
+					acctest.CheckRepository(server, "nuget-proxy", "proxy.remoteUrl", "https://api.nuget.org/v3/index.json"),
+					acctest.CheckRepository(server, "nuget-proxy", "nugetProxy.nugetVersion", "V3"),
+					acctest.CheckRepository(server, "nuget-group", "group.memberNames", `["nuget-hosted","nuget-proxy"]`),
+				),
+			},
+			{
+				Config: config("ALLOW", "V2", "[nexus_repository_nuget_proxy.test.name, nexus_repository_nuget_hosted.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_nuget_hosted.test", "storage.write_policy", "ALLOW"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_group.test", "group.member_names.0", "nuget-proxy"),
+					resource.TestCheckResourceAttr("nexus_repository_nuget_proxy.test", "nuget_version", "V2"),
+					acctest.CheckRepository(server, "nuget-hosted", "storage.writePolicy", "ALLOW"),
+					acctest.CheckRepository(server, "nuget-proxy", "nugetProxy.nugetVersion", "V2"),
+					acctest.CheckRepository(server, "nuget-group", "group.memberNames", `["nuget-proxy","nuget-hosted"]`),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_nuget_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "nuget-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "nexus_repository_nuget_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "nuget-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+			{
+				ResourceName:      "nexus_repository_nuget_group.test",
+				ImportState:       true,
+				ImportStateId:     "nuget-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryPypiGroup() resource.Resource {
+	return &groupRepositoryResource[repository.PypiGroupRepository, RepositoryGroupResourceModel]{
+		format: "pypi",
+		attributes: map[string]schema.Attribute{
+			"group": groupResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.PypiGroupRepository] {
+			return client.Repository.Pypi.Group
+		},
+		expand:  expandGroupRepository[repository.PypiGroupRepository],
+		flatten: flattenGroupRepository[repository.PypiGroupRepository],
+	}
+}
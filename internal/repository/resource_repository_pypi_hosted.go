@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryPypiHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.PypiHostedRepository, RepositoryHostedModel]{
+		format: "pypi",
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.PypiHostedRepository] {
+			return client.Repository.Pypi.Hosted
+		},
+		expand:  expandHostedRepository[repository.PypiHostedRepository],
+		flatten: flattenHostedRepository[repository.PypiHostedRepository],
+	}
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryPypiProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.PypiProxyRepository, RepositoryProxyResourceModel]{
+		format: "pypi",
+		attributes: map[string]schema.Attribute{
+			"http_client": httpClientResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.PypiProxyRepository] {
+			return client.Repository.Pypi.Proxy
+		},
+		expand:  expandProxyRepository[repository.PypiProxyRepository],
+		flatten: flattenProxyRepository[repository.PypiProxyRepository],
+	}
+}
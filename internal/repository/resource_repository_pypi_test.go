@@ -0,0 +1,120 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryPypi(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(writePolicy string, members string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_pypi_hosted" "test" {
+  name   = "pypi-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "` + writePolicy + `"
+  }
+}
+
+resource "nexus_repository_pypi_proxy" "test" {
+  name   = "pypi-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url       = "https://pypi.org/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "pypi"
+      password = "secret"
+    }
+  }
+}
+
+resource "nexus_repository_pypi_group" "test" {
+  name   = "pypi-group"
+  online = true
+
+  group = {
+    member_names = ` + members + `
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ALLOW_ONCE", "[nexus_repository_pypi_hosted.test.name, nexus_repository_pypi_proxy.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_pypi_hosted.test", "storage.write_policy", "ALLOW_ONCE"),
+					resource.TestCheckResourceAttr("nexus_repository_pypi_proxy.test", "http_client.authentication.password", "secret"),
+					resource.TestCheckResourceAttr("nexus_repository_pypi_group.test", "group.member_names.#", "2"),
+					acctest.CheckRepository(server, "pypi-hosted", "format", "pypi"),
+					acctest.CheckRepository(server, "pypi-hosted", "storage.writePolicy", "ALLOW_ONCE"),
+					acctest.CheckRepository(server, "pypi-proxy", "proxy.remoteUrl", "https://pypi.org/"),
+					acctest.CheckRepository(server, "pypi-group", "group.memberNames", `["pypi-hosted","pypi-proxy"]`),
+				),
+			},
+			{
+				Config: config("ALLOW", "[nexus_repository_pypi_proxy.test.name, nexus_repository_pypi_hosted.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_pypi_hosted.test", "storage.write_policy", "ALLOW"),
+					resource.TestCheckResourceAttr("nexus_repository_pypi_group.test", "group.member_names.0", "pypi-proxy"),
+					acctest.CheckRepository(server, "pypi-hosted", "storage.writePolicy", "ALLOW"),
+					acctest.CheckRepository(server, "pypi-group", "group.memberNames", `["pypi-proxy","pypi-hosted"]`),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_pypi_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "pypi-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "nexus_repository_pypi_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "pypi-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+			{
+				ResourceName:      "nexus_repository_pypi_group.test",
+				ImportState:       true,
+				ImportStateId:     "pypi-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
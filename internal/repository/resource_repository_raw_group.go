@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryRawGroupResourceModel struct {
+	RepositoryGroupModel
+	Raw   *RawModel   `tfsdk:"raw"`
+	Group *GroupModel `tfsdk:"group"`
+}
+
+func NewResourceRepositoryRawGroup() resource.Resource {
+	return &groupRepositoryResource[repository.RawGroupRepository, RepositoryRawGroupResourceModel]{
+		format: "raw",
+		attributes: map[string]schema.Attribute{
+			"raw":   rawResourceSchema(),
+			"group": groupResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RawGroupRepository] {
+			return client.Repository.Raw.Group
+		},
+		expand:  RepositoryRawGroupResourceModel.toRepository,
+		flatten: flattenRawGroupRepository,
+	}
+}
+
+func (m RepositoryRawGroupResourceModel) toRepository() repository.RawGroupRepository {
+	return repository.RawGroupRepository{
+		Name:    m.Name.ValueString(),
+		Online:  m.Online.ValueBool(),
+		Raw:     expandRaw(m.Raw),
+		Group:   expandGroup(m.Group),
+		Storage: expandProxyStorage(m.Storage),
+	}
+}
+
+func flattenRawGroupRepository(repo repository.RawGroupRepository, prior RepositoryRawGroupResourceModel) RepositoryRawGroupResourceModel {
+	return RepositoryRawGroupResourceModel{
+		RepositoryGroupModel: RepositoryGroupModel{
+			Id:      types.StringValue(repo.Name),
+			Name:    types.StringValue(repo.Name),
+			Online:  types.BoolValue(repo.Online),
+			Storage: flattenProxyStorage(repo.Storage),
+		},
+		Raw:   flattenRaw(repo.Raw, prior.Raw),
+		Group: flattenGroup(repo.Group),
+	}
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryRawHostedResourceModel struct {
+	RepositoryHostedModel
+	Raw *RawModel `tfsdk:"raw"`
+}
+
+func NewResourceRepositoryRawHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.RawHostedRepository, RepositoryRawHostedResourceModel]{
+		format: "raw",
+		attributes: map[string]schema.Attribute{
+			"raw": rawResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RawHostedRepository] {
+			return client.Repository.Raw.Hosted
+		},
+		expand:  RepositoryRawHostedResourceModel.toRepository,
+		flatten: flattenRawHostedRepository,
+	}
+}
+
+func (m RepositoryRawHostedResourceModel) toRepository() repository.RawHostedRepository {
+	return repository.RawHostedRepository{
+		Name:      m.Name.ValueString(),
+		Online:    m.Online.ValueBool(),
+		Storage:   expandHostedStorage(m.Storage),
+		Raw:       expandRaw(m.Raw),
+		Cleanup:   expandCleanup(m.Cleanup),
+		Component: expandComponent(m.Component),
+	}
+}
+
+func flattenRawHostedRepository(repo repository.RawHostedRepository, prior RepositoryRawHostedResourceModel) RepositoryRawHostedResourceModel {
+	return RepositoryRawHostedResourceModel{
+		RepositoryHostedModel: RepositoryHostedModel{
+			Id:        types.StringValue(repo.Name),
+			Name:      types.StringValue(repo.Name),
+			Online:    types.BoolValue(repo.Online),
+			Cleanup:   flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Component: flattenComponent(repo.Component, prior.Component),
+			Storage:   flattenHostedStorage(repo.Storage),
+		},
+		Raw: flattenRaw(repo.Raw, prior.Raw),
+	}
+}
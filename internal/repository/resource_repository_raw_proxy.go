@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+type RepositoryRawProxyResourceModel struct {
+	RepositoryProxyModel
+	Raw        *RawModel        `tfsdk:"raw"`
+	HttpClient *HttpClientModel `tfsdk:"http_client"`
+}
+
+func NewResourceRepositoryRawProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.RawProxyRepository, RepositoryRawProxyResourceModel]{
+		format: "raw",
+		attributes: map[string]schema.Attribute{
+			"raw":         rawResourceSchema(),
+			"http_client": httpClientResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RawProxyRepository] {
+			return client.Repository.Raw.Proxy
+		},
+		expand:  RepositoryRawProxyResourceModel.toRepository,
+		flatten: flattenRawProxyRepository,
+	}
+}
+
+func (m RepositoryRawProxyResourceModel) toRepository() repository.RawProxyRepository {
+	return repository.RawProxyRepository{
+		Name:          m.Name.ValueString(),
+		Online:        m.Online.ValueBool(),
+		Storage:       expandProxyStorage(m.Storage),
+		Proxy:         expandProxy(m.Proxy),
+		NegativeCache: expandNegativeCache(m.NegativeCache),
+		HTTPClient:    expandHTTPClient(m.HttpClient),
+		Raw:           expandRaw(m.Raw),
+		RoutingRule:   m.RoutingRule.ValueStringPointer(),
+		Cleanup:       expandCleanup(m.Cleanup),
+	}
+}
+
+func flattenRawProxyRepository(repo repository.RawProxyRepository, prior RepositoryRawProxyResourceModel) RepositoryRawProxyResourceModel {
+	return RepositoryRawProxyResourceModel{
+		RepositoryProxyModel: RepositoryProxyModel{
+			Id:            types.StringValue(repo.Name),
+			Name:          types.StringValue(repo.Name),
+			Online:        types.BoolValue(repo.Online),
+			RoutingRule:   flattenRoutingRule(repo.RoutingRule, repo.RoutingRuleName),
+			Cleanup:       flattenCleanup(repo.Cleanup, prior.Cleanup),
+			Storage:       flattenProxyStorage(repo.Storage),
+			Proxy:         flattenProxy(repo.Proxy),
+			NegativeCache: flattenNegativeCache(repo.NegativeCache),
+		},
+		Raw:        flattenRaw(repo.Raw, prior.Raw),
+		HttpClient: flattenHTTPClient(repo.HTTPClient, prior.HttpClient),
+	}
+}
@@ -0,0 +1,130 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+// blobstoreConfig is the blob store the repositories of the tests use.
+const blobstoreConfig = `
+resource "nexus_blobstore_file" "test" {
+  name = "repository-test"
+}
+`
+
+func TestResourceRepositoryRaw(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(contentDisposition string, writePolicy string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_raw_hosted" "test" {
+  name   = "raw-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = false
+    write_policy                   = "` + writePolicy + `"
+  }
+
+  raw = {
+    content_disposition = "` + contentDisposition + `"
+  }
+}
+
+resource "nexus_repository_raw_proxy" "test" {
+  name   = "raw-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url       = "https://example.com/raw/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+  }
+}
+
+resource "nexus_repository_raw_group" "test" {
+  name   = "raw-group"
+  online = true
+
+  group = {
+    member_names = [
+      nexus_repository_raw_hosted.test.name,
+      nexus_repository_raw_proxy.test.name,
+    ]
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+
+data "nexus_repository_raw_group" "test" {
+  name = nexus_repository_raw_group.test.name
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ATTACHMENT", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_raw_hosted.test", "raw.content_disposition", "ATTACHMENT"),
+					resource.TestCheckResourceAttr("nexus_repository_raw_proxy.test", "proxy.remote_url", "https://example.com/raw/"),
+					resource.TestCheckResourceAttr("data.nexus_repository_raw_group.test", "group.member_names.#", "2"),
+					resource.TestCheckResourceAttr("data.nexus_repository_raw_group.test", "group.member_names.0", "raw-hosted"),
+					resource.TestCheckResourceAttr("data.nexus_repository_raw_group.test", "storage.blob_store_name", "repository-test"),
+					acctest.CheckRepository(server, "raw-hosted", "storage.writePolicy", "ALLOW"),
+					acctest.CheckRepository(server, "raw-group", "group.memberNames", `["raw-hosted","raw-proxy"]`),
+				),
+			},
+			{
+				Config: config("INLINE", "ALLOW_ONCE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_raw_hosted.test", "raw.content_disposition", "INLINE"),
+					acctest.CheckRepository(server, "raw-hosted", "raw.contentDisposition", "INLINE"),
+					acctest.CheckRepository(server, "raw-hosted", "storage.writePolicy", "ALLOW_ONCE"),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_raw_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "raw-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "nexus_repository_raw_proxy.test",
+				ImportState:       true,
+				ImportStateId:     "raw-proxy",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "nexus_repository_raw_group.test",
+				ImportState:       true,
+				ImportStateId:     "raw-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryRubyGemsGroup() resource.Resource {
+	return &groupRepositoryResource[repository.RubyGemsGroupRepository, RepositoryGroupResourceModel]{
+		format: "rubygems",
+		attributes: map[string]schema.Attribute{
+			"group": groupResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RubyGemsGroupRepository] {
+			return client.Repository.RubyGems.Group
+		},
+		expand:  expandGroupRepository[repository.RubyGemsGroupRepository],
+		flatten: flattenGroupRepository[repository.RubyGemsGroupRepository],
+	}
+}
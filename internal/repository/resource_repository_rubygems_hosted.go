@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryRubyGemsHosted() resource.Resource {
+	return &hostedRepositoryResource[repository.RubyGemsHostedRepository, RepositoryHostedModel]{
+		format: "rubygems",
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RubyGemsHostedRepository] {
+			return client.Repository.RubyGems.Hosted
+		},
+		expand:  expandHostedRepository[repository.RubyGemsHostedRepository],
+		flatten: flattenHostedRepository[repository.RubyGemsHostedRepository],
+	}
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+func NewResourceRepositoryRubyGemsProxy() resource.Resource {
+	return &proxyRepositoryResource[repository.RubyGemsProxyRepository, RepositoryProxyResourceModel]{
+		format: "rubygems",
+		attributes: map[string]schema.Attribute{
+			"http_client": httpClientResourceSchema(),
+		},
+		service: func(client *nexusclient.Client) *nexusclient.RepositoryTypeService[repository.RubyGemsProxyRepository] {
+			return client.Repository.RubyGems.Proxy
+		},
+		expand:  expandProxyRepository[repository.RubyGemsProxyRepository],
+		flatten: flattenProxyRepository[repository.RubyGemsProxyRepository],
+	}
+}
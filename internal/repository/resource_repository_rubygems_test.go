@@ -0,0 +1,120 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+)
+
+func TestResourceRepositoryRubyGems(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	config := func(writePolicy string, members string) string {
+		return server.ProviderConfig() + blobstoreConfig + `
+resource "nexus_repository_rubygems_hosted" "test" {
+  name   = "rubygems-hosted"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+    write_policy                   = "` + writePolicy + `"
+  }
+}
+
+resource "nexus_repository_rubygems_proxy" "test" {
+  name   = "rubygems-proxy"
+  online = true
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+
+  proxy = {
+    remote_url       = "https://rubygems.org/"
+    content_max_age  = 1440
+    metadata_max_age = 1440
+  }
+
+  negative_cache = {
+    enabled = true
+    ttl     = 1440
+  }
+
+  http_client = {
+    blocked    = false
+    auto_block = true
+
+    authentication = {
+      type     = "username"
+      username = "rubygems"
+      password = "secret"
+    }
+  }
+}
+
+resource "nexus_repository_rubygems_group" "test" {
+  name   = "rubygems-group"
+  online = true
+
+  group = {
+    member_names = ` + members + `
+  }
+
+  storage = {
+    blob_store_name                = nexus_blobstore_file.test.name
+    strict_content_type_validation = true
+  }
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config("ALLOW_ONCE", "[nexus_repository_rubygems_hosted.test.name, nexus_repository_rubygems_proxy.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_rubygems_hosted.test", "storage.write_policy", "ALLOW_ONCE"),
+					resource.TestCheckResourceAttr("nexus_repository_rubygems_proxy.test", "http_client.authentication.password", "secret"),
+					resource.TestCheckResourceAttr("nexus_repository_rubygems_group.test", "group.member_names.#", "2"),
+					acctest.CheckRepository(server, "rubygems-hosted", "format", "rubygems"),
+					acctest.CheckRepository(server, "rubygems-hosted", "storage.writePolicy", "ALLOW_ONCE"),
+					acctest.CheckRepository(server, "rubygems-proxy", "proxy.remoteUrl", "https://rubygems.org/"),
+					acctest.CheckRepository(server, "rubygems-group", "group.memberNames", `["rubygems-hosted","rubygems-proxy"]`),
+				),
+			},
+			{
+				Config: config("ALLOW", "[nexus_repository_rubygems_proxy.test.name, nexus_repository_rubygems_hosted.test.name]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("nexus_repository_rubygems_hosted.test", "storage.write_policy", "ALLOW"),
+					resource.TestCheckResourceAttr("nexus_repository_rubygems_group.test", "group.member_names.0", "rubygems-proxy"),
+					acctest.CheckRepository(server, "rubygems-hosted", "storage.writePolicy", "ALLOW"),
+					acctest.CheckRepository(server, "rubygems-group", "group.memberNames", `["rubygems-proxy","rubygems-hosted"]`),
+				),
+			},
+			{
+				ResourceName:      "nexus_repository_rubygems_hosted.test",
+				ImportState:       true,
+				ImportStateId:     "rubygems-hosted",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "nexus_repository_rubygems_proxy.test",
+				ImportState:             true,
+				ImportStateId:           "rubygems-proxy",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"http_client.authentication.password"},
+			},
+			{
+				ResourceName:      "nexus_repository_rubygems_group.test",
+				ImportState:       true,
+				ImportStateId:     "rubygems-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/nduyphuong/go-nexus-client/nexus3/pkg/tools"
+	"github.com/nduyphuong/go-nexus-client/nexus3/schema/repository"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+const (
+	repositoriesAPIEndpoint       = nexusclient.BasePath + "v1/repositories"
+	repositorySettingsAPIEndpoint = nexusclient.BasePath + "v1/repositorySettings"
+)
+
+// repositoryExists reports whether a repository of any format is named name.
+// The format specific getters fail on missing repositories, so resources use
+// it to notice repositories deleted outside of terraform.
+func repositoryExists(client *nexusclient.Client, name string) (bool, error) {
+	repos, err := client.Repository.List()
+	if err != nil {
+		return false, err
+	}
+	for _, repo := range repos {
+		if repo.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func proxyStorageResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The storage configuration of the repository",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"blob_store_name": schema.StringAttribute{
+				Description: "Blob store used to store repository contents",
+				Required:    true,
+			},
+			"strict_content_type_validation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to validate uploaded content's MIME type appropriate for the repository format. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func proxyStorageDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "The storage configuration of the repository",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"blob_store_name": dschema.StringAttribute{
+				Description: "Blob store used to store repository contents",
+				Computed:    true,
+			},
+			"strict_content_type_validation": dschema.BoolAttribute{
+				Description: "Whether to validate uploaded content's MIME type appropriate for the repository format",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandProxyStorage(m *StorageDataSourceModel) repository.Storage {
+	if m == nil {
+		return repository.Storage{}
+	}
+	return repository.Storage{
+		BlobStoreName:               m.BlobStoreName.ValueString(),
+		StrictContentTypeValidation: m.StrictContentTypeValidation.ValueBool(),
+	}
+}
+
+func flattenProxyStorage(s repository.Storage) *StorageDataSourceModel {
+	return &StorageDataSourceModel{
+		BlobStoreName:               types.StringValue(s.BlobStoreName),
+		StrictContentTypeValidation: types.BoolValue(s.StrictContentTypeValidation),
+	}
+}
+
+func cleanupResourceSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Cleanup policies",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"policy_names": schema.SetAttribute{
+				Description: "List of policy names",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func cleanupDataSourceSchema() dschema.SingleNestedAttribute {
+	return dschema.SingleNestedAttribute{
+		Description: "Cleanup policies",
+		Computed:    true,
+		Attributes: map[string]dschema.Attribute{
+			"policy_names": dschema.SetAttribute{
+				Description: "List of policy names",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func expandCleanup(m *CleanupModel) *repository.Cleanup {
+	if m == nil {
+		return nil
+	}
+	cleanup := &repository.Cleanup{PolicyNames: []string{}}
+	for _, name := range m.PolicyNames {
+		cleanup.PolicyNames = append(cleanup.PolicyNames, name.ValueString())
+	}
+	return cleanup
+}
+
+// flattenCleanup keeps an empty cleanup block of the prior state, nexus does
+// not distinguish it from a missing one.
+func flattenCleanup(c *repository.Cleanup, prior *CleanupModel) *CleanupModel {
+	if c == nil || len(c.PolicyNames) == 0 {
+		if prior != nil {
+			return &CleanupModel{PolicyNames: []types.String{}}
+		}
+		return nil
+	}
+	cleanup := &CleanupModel{}
+	for _, name := range c.PolicyNames {
+		cleanup.PolicyNames = append(cleanup.PolicyNames, types.StringValue(name))
+	}
+	return cleanup
+}
+
+// flattenRoutingRule reads the routing rule, which nexus accepts as
+// routingRule but returns as routingRuleName.
+func flattenRoutingRule(routingRule *string, routingRuleName *string) types.String {
+	for _, rule := range []*string{routingRuleName, routingRule} {
+		if rule != nil && *rule != "" {
+			return types.StringValue(*rule)
+		}
+	}
+	return types.StringNull()
+}
+
+// keepWriteOnly implements the rule for credentials nexus does not return, or
+// returns masked: the value is always taken from prior, the plan or the
+// previous state, and never from the nexus api. Data sources have no prior
+// value, so they read write-only credentials as null.
+func keepWriteOnly(prior types.String) types.String {
+	if prior.IsUnknown() {
+		return types.StringNull()
+	}
+	return prior
+}
+
+// repositorySettings are the settings of a repository of any format, as
+// returned by the repository settings list.
+type repositorySettings struct {
+	repository.LegacyRepository
+	URL string `json:"url"`
+}
+
+// listRepositorySettings returns the settings of all repositories at once.
+// Unlike the repository list, they contain the online status and storage.
+func listRepositorySettings(client *nexusclient.Client) ([]repositorySettings, error) {
+	body, resp, err := client.Get(repositorySettingsAPIEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list repository settings: HTTP: %d, %s", resp.StatusCode, string(body))
+	}
+	var settings []repositorySettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return nil, fmt.Errorf("could not unmarshal repository settings: %v", err)
+	}
+	return settings, nil
+}
+
+// createRepository, getRepository and updateRepository call the format
+// specific repository api directly, for the settings the nexus client types
+// are missing. repo is marshalled to, or unmarshalled from, the json body.
+func createRepository(client *nexusclient.Client, format string, repoType string, name string, repo interface{}) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := client.Post(fmt.Sprintf("%s/%s/%s", repositoriesAPIEndpoint, format, repoType), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func getRepository(client *nexusclient.Client, format string, repoType string, name string, repo interface{}) error {
+	body, resp, err := client.Get(fmt.Sprintf("%s/%s/%s/%s", repositoriesAPIEndpoint, format, repoType, name), nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not read repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	if err := json.Unmarshal(body, repo); err != nil {
+		return fmt.Errorf("could not unmarshal repository: %v", err)
+	}
+	return nil
+}
+
+func updateRepository(client *nexusclient.Client, format string, repoType string, name string, repo interface{}) error {
+	ioReader, err := tools.JsonMarshalInterfaceToIOReader(repo)
+	if err != nil {
+		return err
+	}
+	body, resp, err := client.Put(fmt.Sprintf("%s/%s/%s/%s", repositoriesAPIEndpoint, format, repoType, name), ioReader)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("could not update repository '%s': HTTP: %d, %s", name, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/serialt/terraform-provider-nexus/internal/nexusclient"
+)
+
+var _ datasource.DataSource = &ServerInfoSource{}
+
+func NewServerInfoSource() datasource.DataSource {
+	return &ServerInfoSource{}
+}
+
+// ServerInfoSource defines the data source implementation.
+type ServerInfoSource struct {
+	client *nexusclient.Client
+}
+
+type ServerInfoSourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Edition types.String `tfsdk:"edition"`
+	Version types.String `tfsdk:"version"`
+	NodeId  types.String `tfsdk:"node_id"`
+}
+
+func (d *ServerInfoSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+func (d *ServerInfoSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the edition and version of the nexus server the provider is connected to.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Used to identify data source at nexus",
+				Computed:    true,
+			},
+			"edition": schema.StringAttribute{
+				MarkdownDescription: "The edition of nexus, such as `OSS` or `PRO`. Empty when neither the Server header nor the api reveal it.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of nexus, such as `3.70.1-02`. Empty when neither the Server header nor the api reveal it.",
+				Computed:            true,
+			},
+			"node_id": schema.StringAttribute{
+				Description: "The id of the nexus node, null when the user is not allowed to read it",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ServerInfoSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*nexusclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *nexusclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+func (d *ServerInfoSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	state := ServerInfoSourceModel{
+		Id:      types.StringValue("server"),
+		Edition: types.StringValue(d.client.Server.Edition),
+		Version: types.StringValue(d.client.Server.Version),
+		NodeId:  types.StringNull(),
+	}
+
+	nodeID, err := d.client.NodeID()
+	if err != nil {
+		tflog.Debug(ctx, "could not read the node id of nexus", map[string]interface{}{"error": err.Error()})
+	} else {
+		state.Id = types.StringValue(nodeID)
+		state.NodeId = types.StringValue(nodeID)
+	}
+
+	tflog.Trace(ctx, "read a server info data source")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
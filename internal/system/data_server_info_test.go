@@ -0,0 +1,30 @@
+package system_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/serialt/terraform-provider-nexus/internal/acctest"
+	"github.com/serialt/terraform-provider-nexus/internal/mocknexus"
+)
+
+func TestDataSourceServerInfo(t *testing.T) {
+	server := acctest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "nexus_server_info" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "edition", mocknexus.DefaultEdition),
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "version", mocknexus.DefaultVersion),
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "node_id", server.NodeID),
+					resource.TestCheckResourceAttr("data.nexus_server_info.test", "id", server.NodeID),
+				),
+			},
+		},
+	})
+}
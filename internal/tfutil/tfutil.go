@@ -0,0 +1,57 @@
+// Package tfutil contains the value conversions and validators shared by the
+// blob store and repository resources and data sources.
+package tfutil
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringValueOrNull maps the empty strings nexus returns for unset fields to null.
+func StringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// NameRegexp is the name pattern nexus enforces for blob stores and
+// repositories.
+var NameRegexp = regexp.MustCompile(`^[a-zA-Z0-9\-][a-zA-Z0-9_\-.]*$`)
+
+// NameValidators validate the name of a blob store or repository.
+func NameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, 255),
+		stringvalidator.RegexMatches(
+			NameRegexp,
+			"must start with a letter, digit or '-' and only contain letters, digits, '_', '-' and '.'",
+		),
+	}
+}
+
+// RegexValidator checks that a string is a valid regular expression.
+type RegexValidator struct{}
+
+var _ validator.String = RegexValidator{}
+
+func (v RegexValidator) Description(ctx context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v RegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v RegexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Regular Expression", err.Error())
+	}
+}
@@ -0,0 +1,41 @@
+package tfutil_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/serialt/terraform-provider-nexus/internal/tfutil"
+)
+
+func TestNameValidators(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		valid bool
+	}{
+		{name: "releases", valid: true},
+		{name: "maven-central.2", valid: true},
+		{name: "-snapshots_old", valid: true},
+		{name: "9", valid: true},
+		{name: "_hidden"},
+		{name: ".hidden"},
+		{name: "with space"},
+		{name: "a/b"},
+		{name: ""},
+		{name: strings.Repeat("a", 256)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tc.name)}
+			var resp validator.StringResponse
+			for _, v := range tfutil.NameValidators() {
+				v.ValidateString(context.Background(), req, &resp)
+			}
+			if got := !resp.Diagnostics.HasError(); got != tc.valid {
+				t.Errorf("got valid %v, want %v: %v", got, tc.valid, resp.Diagnostics)
+			}
+		})
+	}
+}